@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
@@ -17,13 +16,13 @@ type adjustmentService service
 
 // Adjustment data type
 type Adjustment struct {
-	UID                 string          `json:"uid,omitempty"`
-	ExternalUID         string          `json:"external_uid,omitempty"`
-	CustomerUID         string          `json:"customer_uid,omitempty"`
-	USDAdjustmentAmount string          `json:"usd_adjustment_amount,omitempty"`
-	AdjustmentType      *AdjustmentType `json:"adjustment_type,omitempty"`
-	CreatedAt           time.Time       `json:"created_at,omitempty"`
-	Status              string          `json:"status,omitempty"`
+	UID                 string           `json:"uid,omitempty"`
+	ExternalUID         string           `json:"external_uid,omitempty"`
+	CustomerUID         string           `json:"customer_uid,omitempty"`
+	USDAdjustmentAmount Money            `json:"usd_adjustment_amount"`
+	AdjustmentType      *AdjustmentType  `json:"adjustment_type,omitempty"`
+	CreatedAt           time.Time        `json:"created_at"`
+	Status              AdjustmentStatus `json:"status,omitempty"`
 }
 
 // AdjustmentType data type
@@ -38,19 +37,19 @@ type AdjustmentType struct {
 
 // AdjustmentListParams builds the query parameters used in querying Adjustments
 type AdjustmentListParams struct {
-	CustomerUID            string `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
-	AdjustmentTypeUID      string `url:"adjustment_type_uid,omitempty" json:"adjustment_type_uid,omitempty"`
-	ExternalUID            string `url:"external_uid,omitempty" json:"external_uid,omitempty"`
-	USDAdjustmentAmountMax int    `url:"usd_adjustment_amount_max,omitempty" json:"usd_adjustment_amount_max,omitempty"`
-	USDAdjustmentAmountMin int    `url:"usd_adjustment_amount_min,omitempty" json:"usd_adjustment_amount_min,omitempty"`
-	Sort                   string `url:"sort,omitempty" json:"sort,omitempty"`
+	CustomerUID            string          `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
+	AdjustmentTypeUID      string          `url:"adjustment_type_uid,omitempty" json:"adjustment_type_uid,omitempty"`
+	ExternalUID            string          `url:"external_uid,omitempty" json:"external_uid,omitempty"`
+	USDAdjustmentAmountMax Optional[Money] `url:"usd_adjustment_amount_max,omitempty" json:"usd_adjustment_amount_max"`
+	USDAdjustmentAmountMin Optional[Money] `url:"usd_adjustment_amount_min,omitempty" json:"usd_adjustment_amount_min"`
+	Sort                   string          `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // AdjustmentCreateParams are the body params used when creating a new Adjustment
 type AdjustmentCreateParams struct {
 	ExternalUID         string `json:"external_uid,omitempty"`
 	CustomerUID         string `json:"customer_uid"`
-	USDAdjustmentAmount string `json:"usd_adjustment_amount"`
+	USDAdjustmentAmount Money  `json:"usd_adjustment_amount"`
 	AdjustmentTypeUID   string `json:"adjustment_type_uid"`
 }
 
@@ -74,26 +73,15 @@ type AdjustmentTypeListResponse struct {
 }
 
 // List retrieves a list of Adjustments filtered by the given parameters
-func (a *adjustmentService) List(ctx context.Context, params *AdjustmentListParams) (*AdjustmentListResponse, error) {
+func (a *adjustmentService) List(ctx context.Context, params *AdjustmentListParams, opts ...CallOption) (*AdjustmentListResponse, error) {
 	// Build AdjustmentListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := a.client.doRequest(ctx, http.MethodGet, "adjustments", v, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AdjustmentListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := a.client.doRequest(ctx, newOperation("Adjustments.List", http.MethodGet, "adjustments"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -101,56 +89,46 @@ func (a *adjustmentService) List(ctx context.Context, params *AdjustmentListPara
 }
 
 // Create a new Adjustment with the provided specification
-func (a *adjustmentService) Create(ctx context.Context, params *AdjustmentCreateParams) (*Adjustment, error) {
+// If no ExternalUID is provided, the key set with WithIdempotencyKey is used, or one is generated.
+// The params are not modified; the ExternalUID is returned on the created resource
+func (a *adjustmentService) Create(ctx context.Context, params *AdjustmentCreateParams, opts ...CallOption) (*Adjustment, error) {
 	if params.CustomerUID == "" ||
-		params.USDAdjustmentAmount == "" ||
+		params.USDAdjustmentAmount.IsZero() ||
 		params.AdjustmentTypeUID == "" {
 		return nil, fmt.Errorf("CustomerUID, USDAdjustmentAmount and AdjustmentTypeUID are required")
 	}
 
-	bytesMessage, err := json.Marshal(params)
+	p := *params
+	p.ExternalUID = createExternalUID(p.ExternalUID, opts)
+	bytesMessage, err := json.Marshal(&p)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := a.client.doRequest(ctx, http.MethodPost, "adjustments", nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
+	create := func(ctx context.Context, opts ...CallOption) (*Adjustment, error) {
+		response := &Adjustment{}
+		_, err := a.client.doRequest(ctx, newOperation("Adjustments.Create", http.MethodPost, "adjustments"), &p, nil, bytes.NewBuffer(bytesMessage), response, opts...)
+		return response, err
 	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	response := &Adjustment{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+	list := func(ctx context.Context, opts ...CallOption) ([]*Adjustment, error) {
+		resp, err := a.List(ctx, &AdjustmentListParams{ExternalUID: p.ExternalUID}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
 	}
 
-	return response, nil
+	return createWithExternalUID(ctx, a.client, opts, create, list)
 }
 
 // Get returns a single Adjustment
-func (a *adjustmentService) Get(ctx context.Context, uid string) (*Adjustment, error) {
+func (a *adjustmentService) Get(ctx context.Context, uid string, opts ...CallOption) (*Adjustment, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := a.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("adjustments/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Adjustment{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := a.client.doRequest(ctx, newOperation("Adjustments.Get", http.MethodGet, "adjustments/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -158,25 +136,14 @@ func (a *adjustmentService) Get(ctx context.Context, uid string) (*Adjustment, e
 }
 
 // ListAdjustmentTypes retrieves a list of Adjustment Types filtered by the given parameters
-func (a *adjustmentService) ListAdjustmentTypes(ctx context.Context, params *AdjustmentTypeListParams) (*AdjustmentTypeListResponse, error) {
+func (a *adjustmentService) ListAdjustmentTypes(ctx context.Context, params *AdjustmentTypeListParams, opts ...CallOption) (*AdjustmentTypeListResponse, error) {
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := a.client.doRequest(ctx, http.MethodGet, "adjustment_types", v, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AdjustmentTypeListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := a.client.doRequest(ctx, newOperation("Adjustments.ListAdjustmentTypes", http.MethodGet, "adjustment_types"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -184,24 +151,13 @@ func (a *adjustmentService) ListAdjustmentTypes(ctx context.Context, params *Adj
 }
 
 // GetAdjustmentType returns a single Adjustment Type
-func (a *adjustmentService) GetAdjustmentType(ctx context.Context, uid string) (*AdjustmentType, error) {
+func (a *adjustmentService) GetAdjustmentType(ctx context.Context, uid string, opts ...CallOption) (*AdjustmentType, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := a.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("adjustment_types/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AdjustmentType{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := a.client.doRequest(ctx, newOperation("Adjustments.GetAdjustmentType", http.MethodGet, "adjustment_types/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"time"
 
@@ -21,59 +20,214 @@ type AuthTokenResponse struct {
 	Token string `json:"token"`
 }
 
+// A token refresh in progress. Concurrent callers wait on `done` and share the result
+type tokenRefresh struct {
+	done  chan struct{}
+	token *TokenCache
+	err   error
+}
+
 // GetToken generates an authorization token if the existing token is expired or not found.
-// Otherwise, it will return the existing active token,
+// Otherwise, it will return the existing active token. Concurrent callers share a single
+// in-flight token request.
 func (a *authService) GetToken(ctx context.Context) (*AuthTokenResponse, error) {
+	rc := a.client
+
+	rc.tokenMu.Lock()
+
 	// Check for missing or expired token
-	if a.client.TokenCache.Token == "" || isExpired(a.client.TokenCache) {
-		log.Println("Token is expired or does not exist. Fetching new token...")
+	if token := rc.token; !isExpired(token, rc.cfg.Clock(), rc.cfg.TokenRefreshMargin) {
+		rc.tokenMu.Unlock()
 
-		refreshToken, err := a.buildRefreshToken()
-		if err != nil {
-			return nil, err
-		}
-		// Store the refresh token (valid for 30 seconds)
-		a.client.TokenCache.Token = refreshToken
+		rc.cfg.Logger.Debug("Using existing auth token")
 
-		res, err := a.client.doRequest(ctx, http.MethodPost, "auth", nil, nil)
-		if err != nil {
-			return nil, err
-		}
-		defer res.Body.Close()
+		return &AuthTokenResponse{Token: token.Token}, nil
+	}
 
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			return nil, err
-		}
+	// Start a refresh unless one is already in progress. It runs on a context detached from the
+	// caller's, so a caller giving up does not fail the refresh for the callers waiting on it
+	refresh := rc.refreshing
+	if refresh == nil {
+		refresh = &tokenRefresh{done: make(chan struct{})}
+		rc.refreshing = refresh
+		go a.refresh(detachContext(ctx), refresh)
+	}
+	rc.tokenMu.Unlock()
 
-		response := &AuthTokenResponse{}
-		if err = json.Unmarshal(body, response); err != nil {
-			return nil, err
-		}
+	select {
+	case <-refresh.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if refresh.err != nil {
+		return nil, refresh.err
+	}
+
+	return &AuthTokenResponse{Token: refresh.token.Token}, nil
+}
+
+// Runs a token refresh shared by concurrent callers of GetToken, and publishes its result
+func (a *authService) refresh(ctx context.Context, refresh *tokenRefresh) {
+	rc := a.client
+
+	ctx, cancel := context.WithTimeout(ctx, rc.cfg.Timeout)
+	defer cancel()
+
+	refresh.token, refresh.err = a.loadToken(ctx)
+
+	rc.tokenMu.Lock()
+	if refresh.err == nil {
+		rc.token = refresh.token
+	}
+	rc.refreshing = nil
+	rc.tokenMu.Unlock()
+	close(refresh.done)
+}
+
+// Context that keeps the values of its parent (such as the trace span), but not its deadline or
+// cancellation
+type detachedContext struct {
+	context.Context
+}
+
+// Returns a context with the values of ctx that is never canceled
+func detachContext(ctx context.Context) context.Context {
+	return detachedContext{ctx}
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+// Loads a valid token from the TokenStore. If the stored token is missing or expired, a new token is
+// fetched and saved while holding the store lock, so only one client refreshes the token at a time.
+func (a *authService) loadToken(ctx context.Context) (*TokenCache, error) {
+	store := a.client.tokenStore
+
+	// Another client may have already stored a valid token
+	token, err := store.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a.isOwnToken(token) && !isExpired(token, a.client.cfg.Clock(), a.client.cfg.TokenRefreshMargin) {
+		a.client.cfg.Logger.Debug("Using auth token from token store")
+		return token, nil
+	}
+
+	unlock, err := store.Lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// Check again in case the token was refreshed while waiting for the lock
+	if token, err = store.Get(ctx); err != nil {
+		return nil, err
+	}
+	if a.isOwnToken(token) && !isExpired(token, a.client.cfg.Clock(), a.client.cfg.TokenRefreshMargin) {
+		a.client.cfg.Logger.Debug("Using auth token from token store")
+		return token, nil
+	}
+
+	a.client.cfg.Logger.Debug("Auth token is expired or does not exist, fetching new token")
+
+	start := time.Now()
+	fetched, err := a.fetchToken(ctx)
+	a.client.cfg.Metrics.RecordTokenRefresh(TokenRefreshMetrics{Duration: time.Since(start), Err: err})
+	if err != nil {
+		return nil, err
+	}
+
+	// Save token along with its expiry
+	now := a.client.cfg.Clock()
+	token = &TokenCache{
+		Token:      fetched,
+		ProgramUID: a.client.cfg.ProgramUID,
+		BaseURL:    a.client.cfg.BaseURL,
+		Timestamp:  now.Unix(),
+		ExpiresAt:  tokenExpiry(fetched, now).Unix(),
+	}
+	if err := store.Set(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// Reports whether a stored token was issued for the client's program and environment. A store may be
+// shared by clients with different credentials
+func (a *authService) isOwnToken(tc *TokenCache) bool {
+	return tc != nil && tc.ProgramUID == a.client.cfg.ProgramUID && tc.BaseURL == a.client.cfg.BaseURL
+}
+
+// Discards the given token if it is still in use, so the next call to GetToken fetches a new one.
+// Tokens that were already replaced by another refresh are left untouched
+func (a *authService) invalidateToken(ctx context.Context, token string) {
+	rc := a.client
+
+	rc.tokenMu.Lock()
+	if rc.token != nil && rc.token.Token == token {
+		rc.token = nil
+	}
+	rc.tokenMu.Unlock()
 
-		log.Printf("Token response %+v\n", response)
+	// Remove the token from the store so other clients stop using it
+	store := rc.tokenStore
+	unlock, err := store.Lock(ctx)
+	if err != nil {
+		rc.cfg.Logger.Warn("Error locking token store", "error", err)
+		return
+	}
+	defer unlock()
 
-		// Validate token exists
-		if response.Token == "" {
-			return nil, fmt.Errorf("Error fetching auth token")
+	if stored, err := store.Get(ctx); err == nil && stored != nil && stored.Token == token {
+		if err := store.Set(ctx, &TokenCache{}); err != nil {
+			rc.cfg.Logger.Warn("Error clearing token store", "error", err)
 		}
+	}
+}
 
-		//  Save token to client. Auth token is valid for 24hrs
-		a.client.TokenCache.Timestamp = time.Now().Unix()
-		a.client.TokenCache.Token = response.Token
-		return response, nil
+// Requests a new auth token from the API using a signed refresh token
+func (a *authService) fetchToken(ctx context.Context) (string, error) {
+	// The refresh token is only valid for 30 seconds, so it is used for this request alone
+	refreshToken, err := a.buildRefreshToken()
+	if err != nil {
+		return "", err
 	}
 
-	log.Println("Token is valid. Using existing auth token...")
+	op := newOperation("Auth.GetToken", http.MethodPost, "auth")
+	ctx, span := a.client.startSpan(ctx, op)
 
-	return &AuthTokenResponse{Token: a.client.TokenCache.Token}, nil
+	res, err := a.client.sendRequest(ctx, op, nil, nil, nil, refreshToken)
+	endSpan(span, op, res, err)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	response := &AuthTokenResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		return "", err
+	}
+
+	// Validate token exists
+	if response.Token == "" {
+		return "", fmt.Errorf("Error fetching auth token")
+	}
+
+	return response.Token, nil
 }
 
 // Generates a JWT refresh token
 func (a *authService) buildRefreshToken() (string, error) {
 	// Encode JWT token with current time and programUID
 	token := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims{
-		"iat": time.Now().Unix(),
+		"iat": a.client.cfg.Clock().Unix(),
 		"sub": a.client.cfg.ProgramUID,
 	})
 
@@ -83,17 +237,36 @@ func (a *authService) buildRefreshToken() (string, error) {
 		return "", err
 	}
 
-	log.Printf("Signed token %s\n", signedToken)
-
 	return signedToken, nil
 }
 
-// Checks to see if the current Auth token should be refreshed
-func isExpired(tc *TokenCache) bool {
-	currentTime := time.Now().Unix()
-	if tc.Timestamp == 0 || ((currentTime - tc.Timestamp) > internal.TokenMaxAge) {
+// Checks to see if the Auth token is missing or should be refreshed. Tokens are
+// refreshed `margin` before they expire, or a quarter of their lifetime before they expire when
+// that is shorter
+func isExpired(tc *TokenCache, now time.Time, margin time.Duration) bool {
+	if tc == nil || tc.Token == "" || tc.ExpiresAt == 0 {
 		return true
 	}
 
-	return false
+	if tc.Timestamp > 0 && tc.ExpiresAt > tc.Timestamp {
+		lifetime := time.Duration(tc.ExpiresAt-tc.Timestamp) * time.Second
+		if limit := time.Duration(float64(lifetime) * internal.TokenRefreshMaxFraction); margin > limit {
+			margin = limit
+		}
+	}
+
+	return !now.Add(margin).Before(time.Unix(tc.ExpiresAt, 0))
+}
+
+// Determines when an auth token expires. Uses the `exp` claim of the token when present,
+// otherwise the token is assumed to be valid for internal.TokenMaxAge from the time it was issued
+func tokenExpiry(token string, issuedAt time.Time) time.Time {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err == nil {
+		if exp, ok := claims["exp"].(float64); ok {
+			return time.Unix(int64(exp), 0)
+		}
+	}
+
+	return issuedAt.Add(internal.TokenMaxAge)
 }
@@ -0,0 +1,70 @@
+package rize
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// CallOption configures a single API call. CallOptions are accepted by every service method, e.g.
+// `rc.Transfers.Create(ctx, params, rize.WithIdempotencyKey(key))`
+type CallOption func(opts *callOptions)
+
+// Options for a single API call
+type callOptions struct {
+	// Deadline for the call, including retries
+	timeout time.Duration
+	// Additional request headers
+	header http.Header
+	// Receive the response metadata
+	responses []*Response
+	// Send the request once, leaving retries to the caller
+	noRetry bool
+}
+
+// Applies the CallOptions in order
+func newCallOptions(opts []CallOption) *callOptions {
+	co := &callOptions{header: http.Header{}}
+	for _, opt := range opts {
+		opt(co)
+	}
+	return co
+}
+
+// WithCallTimeout limits the duration of the call, including retries. Each attempt is still bound by
+// the timeout of the Client's http.Client
+func WithCallTimeout(timeout time.Duration) CallOption {
+	return func(opts *callOptions) {
+		opts.timeout = timeout
+	}
+}
+
+// WithHeader adds a header to the request, replacing the SDK's default header of the same name
+func WithHeader(key string, value string) CallOption {
+	return func(opts *callOptions) {
+		opts.header.Add(key, value)
+	}
+}
+
+// WithIdempotencyKey sets the `Idempotency-Key` header of the request. Create methods also send the
+// key as the ExternalUID, unless the params set one
+func WithIdempotencyKey(key string) CallOption {
+	return func(opts *callOptions) {
+		opts.header.Set(internal.IdempotencyKeyHeader, key)
+	}
+}
+
+// Sends the request once, without the Client's retries
+func withoutRetries() CallOption {
+	return func(opts *callOptions) {
+		opts.noRetry = true
+	}
+}
+
+// WithRequestID sets the `X-Request-Id` header of the request, to correlate it with the caller's logs
+func WithRequestID(id string) CallOption {
+	return func(opts *callOptions) {
+		opts.header.Set(internal.RequestIDHeader, id)
+	}
+}
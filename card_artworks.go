@@ -2,9 +2,7 @@ package rize
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -37,51 +35,54 @@ type CardArtworkListResponse struct {
 }
 
 // List retrieves a list of Card Artworks, optionally filtering by program
-func (c *cardArtworkService) List(ctx context.Context, params *CardArtworkListParams) (*CardArtworkListResponse, error) {
+func (c *cardArtworkService) List(ctx context.Context, params *CardArtworkListParams, opts ...CallOption) (*CardArtworkListResponse, error) {
 	// Build CardArtworkListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodGet, "card_artworks", v, nil)
-	if err != nil {
+	response := &CardArtworkListResponse{}
+	if _, err := c.client.doRequest(ctx, newOperation("CardArtworks.List", http.MethodGet, "card_artworks"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &CardArtworkListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Card Artworks matching the given parameters, fetching additional pages as needed
+func (c *cardArtworkService) Iterate(ctx context.Context, params *CardArtworkListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*CardArtwork] {
+	if params == nil {
+		params = &CardArtworkListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*CardArtwork, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := c.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Card Artworks matching the given parameters across all pages
+func (c *cardArtworkService) ListAll(ctx context.Context, params *CardArtworkListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*CardArtwork, error) {
+	return c.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Get returns a single Card Artwork resource
-func (c *cardArtworkService) Get(ctx context.Context, uid string) (*CardArtwork, error) {
+func (c *cardArtworkService) Get(ctx context.Context, uid string, opts ...CallOption) (*CardArtwork, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("card_artworks/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &CardArtwork{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("CardArtworks.Get", http.MethodGet, "card_artworks/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,297 @@
+package rize
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// CircuitBreaker configures a circuit breaker for every endpoint group (the first segment of the path,
+// e.g. `customers`). After FailureThreshold consecutive failures (transport errors and 5xx responses)
+// the circuit opens and requests to the group fail immediately with a *CircuitOpenError. Once CoolDown
+// has passed, a single trial request is allowed (half-open). The circuit closes again after
+// SuccessThreshold successful trial requests, or opens again if a trial request fails.
+type CircuitBreaker struct {
+	// Consecutive failures that open the circuit. Defaults to 5
+	FailureThreshold int
+	// Time the circuit stays open before a trial request is allowed. Defaults to 30 seconds
+	CoolDown time.Duration
+	// Successful trial requests required to close the circuit. Defaults to 1
+	SuccessThreshold int
+	// Separate thresholds for endpoint groups. Other groups use the thresholds above
+	Groups map[string]*CircuitBreaker
+}
+
+// CircuitState is the state of a circuit breaker
+type CircuitState int
+
+// Circuit breaker states
+const (
+	// CircuitClosed allows all requests
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests until the cool-down has passed
+	CircuitOpen
+	// CircuitHalfOpen allows a single trial request at a time
+	CircuitHalfOpen
+)
+
+// String returns the name of the state
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// CircuitOpenError is returned when a request is rejected by an open circuit breaker. It matches
+// ErrCircuitOpen with errors.Is
+type CircuitOpenError struct {
+	// Endpoint group of the rejected request
+	Group string
+	// Time at which a trial request will be allowed
+	RetryAt time.Time
+}
+
+// Error returns the error message
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("rize: circuit breaker open for %s until %s", e.Group, e.RetryAt.Format(time.RFC3339))
+}
+
+// Is reports whether the target is ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// Fill in any missing thresholds with the defaults
+func (cb *CircuitBreaker) withDefaults() *CircuitBreaker {
+	breaker := *cb
+	if breaker.FailureThreshold < 1 {
+		breaker.FailureThreshold = internal.CircuitFailureThreshold
+	}
+	if breaker.CoolDown <= 0 {
+		breaker.CoolDown = internal.CircuitCoolDown
+	}
+	if breaker.SuccessThreshold < 1 {
+		breaker.SuccessThreshold = internal.CircuitSuccessThreshold
+	}
+	return &breaker
+}
+
+// Circuit breakers for all endpoint groups, created when a group is first used
+type circuitBreakers struct {
+	cfg      *CircuitBreaker
+	clock    func() time.Time
+	logger   Logger
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// Creates the circuit breakers for the given configuration. Returns nil if no breaker is configured
+func newCircuitBreakers(cfg *CircuitBreaker, clock func() time.Time, logger Logger) *circuitBreakers {
+	if cfg == nil {
+		return nil
+	}
+
+	return &circuitBreakers{
+		cfg:      cfg,
+		clock:    clock,
+		logger:   logger,
+		circuits: map[string]*circuit{},
+	}
+}
+
+// Returns the circuit for the endpoint group
+func (c *circuitBreakers) circuit(group string) *circuit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cb, ok := c.circuits[group]; ok {
+		return cb
+	}
+
+	cfg := c.cfg
+	if groupCfg, ok := c.cfg.Groups[group]; ok && groupCfg != nil {
+		cfg = groupCfg
+	}
+	cb := &circuit{group: group, cfg: cfg.withDefaults(), logger: c.logger}
+	c.circuits[group] = cb
+
+	return cb
+}
+
+// Checks whether a request to the endpoint group may be sent. The returned function must be called
+// with the outcome of the request, or with a nil response and error if the request was not sent
+func (c *circuitBreakers) allow(ctx context.Context, group string) (func(res *http.Response, err error), error) {
+	if c == nil {
+		return func(*http.Response, error) {}, nil
+	}
+
+	cb := c.circuit(group)
+	if err := cb.allow(c.clock()); err != nil {
+		return nil, err
+	}
+
+	return func(res *http.Response, err error) {
+		// Requests that were never sent or were abandoned by the caller say nothing about the
+		// health of the API
+		if (res == nil && err == nil) || ctx.Err() != nil {
+			cb.release()
+			return
+		}
+		cb.record(c.clock(), err != nil || res.StatusCode >= http.StatusInternalServerError)
+	}, nil
+}
+
+// Returns the state of the endpoint group's circuit
+func (c *circuitBreakers) state(group string) CircuitState {
+	if c == nil {
+		return CircuitClosed
+	}
+	return c.circuit(group).currentState(c.clock())
+}
+
+// Returns the states of all circuits that have been used
+func (c *circuitBreakers) states() map[string]CircuitState {
+	states := map[string]CircuitState{}
+	if c == nil {
+		return states
+	}
+
+	c.mu.Lock()
+	circuits := make([]*circuit, 0, len(c.circuits))
+	for _, cb := range c.circuits {
+		circuits = append(circuits, cb)
+	}
+	c.mu.Unlock()
+
+	now := c.clock()
+	for _, cb := range circuits {
+		states[cb.group] = cb.currentState(now)
+	}
+	return states
+}
+
+// Circuit breaker for a single endpoint group
+type circuit struct {
+	group  string
+	cfg    *CircuitBreaker
+	logger Logger
+
+	mu        sync.Mutex
+	state     CircuitState
+	failures  int
+	successes int
+	openedAt  time.Time
+	// Set while the half-open trial request is in flight
+	trial bool
+}
+
+// Rejects the request if the circuit is open, or a trial request is already in flight
+func (cb *circuit) allow(now time.Time) error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitOpen {
+		retryAt := cb.openedAt.Add(cb.cfg.CoolDown)
+		if now.Before(retryAt) {
+			return &CircuitOpenError{Group: cb.group, RetryAt: retryAt}
+		}
+		cb.setState(CircuitHalfOpen)
+	}
+
+	if cb.state == CircuitHalfOpen {
+		if cb.trial {
+			return &CircuitOpenError{Group: cb.group, RetryAt: now.Add(cb.cfg.CoolDown)}
+		}
+		cb.trial = true
+	}
+
+	return nil
+}
+
+// Records the outcome of a request
+func (cb *circuit) record(now time.Time, failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case CircuitClosed:
+		if !failed {
+			cb.failures = 0
+			return
+		}
+		cb.failures++
+		if cb.failures >= cb.cfg.FailureThreshold {
+			cb.open(now)
+		}
+	case CircuitHalfOpen:
+		cb.trial = false
+		if failed {
+			cb.open(now)
+			return
+		}
+		cb.successes++
+		if cb.successes >= cb.cfg.SuccessThreshold {
+			cb.failures = 0
+			cb.successes = 0
+			cb.setState(CircuitClosed)
+		}
+	}
+}
+
+// Frees the trial slot of a request without recording an outcome
+func (cb *circuit) release() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitHalfOpen {
+		cb.trial = false
+	}
+}
+
+// Returns the state, taking an expired cool-down into account
+func (cb *circuit) currentState(now time.Time) CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitOpen && !now.Before(cb.openedAt.Add(cb.cfg.CoolDown)) {
+		return CircuitHalfOpen
+	}
+	return cb.state
+}
+
+// Opens the circuit
+func (cb *circuit) open(now time.Time) {
+	cb.openedAt = now
+	cb.successes = 0
+	cb.setState(CircuitOpen)
+}
+
+// Changes the state and logs the transition
+func (cb *circuit) setState(state CircuitState) {
+	if cb.state == state {
+		return
+	}
+	cb.logger.Warn("Circuit breaker state changed", "group", cb.group, "from", cb.state, "to", state)
+	cb.state = state
+}
+
+// CircuitState returns the state of the circuit breaker for the endpoint group (e.g. `customers`).
+// Always CircuitClosed if no circuit breaker is configured
+func (rc *Client) CircuitState(group string) CircuitState {
+	return rc.breakers.state(group)
+}
+
+// CircuitStates returns the states of the circuit breakers for all endpoint groups that have been
+// called, for use in health checks
+func (rc *Client) CircuitStates() map[string]CircuitState {
+	return rc.breakers.states()
+}
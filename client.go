@@ -1,18 +1,22 @@
 package rize
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/rizefinance/rize-go-sdk/internal"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
 )
 
@@ -36,15 +40,56 @@ type Config struct {
 	// Program within the target environment
 	ProgramUID string
 	// HMAC key for the target environment
-	HMACKey string
+	HMACKey string `redact:"true"`
 	// Rize infrastructure target environment. Defaults to `sandbox``
 	Environment string
 	// Provide your own HTTPClient configuration (optional)
 	HTTPClient *http.Client
+	// Timeout for each API request attempt when no HTTPClient is provided (optional). Defaults to 30 seconds
+	Timeout time.Duration
 	// Change the API base URL for local/unit testing
 	BaseURL string
-	// Enable debug logging
+	// Retry behavior for transient errors (optional). Defaults to `DefaultRetryPolicy()`
+	RetryPolicy *RetryPolicy
+	// Refresh the auth token this long before it expires (optional). Defaults to 5 minutes. Limited to
+	// a quarter of the token lifetime for short-lived tokens
+	TokenRefreshMargin time.Duration
+	// Source of the current time, used to manage token expiry (optional). Defaults to `time.Now`
+	Clock func() time.Time
+	// Storage for the auth token, which can be shared between clients and processes (optional).
+	// Defaults to a new `MemoryTokenStore` for each Client
+	TokenStore TokenStore
+	// Source of the tracer used to create a span for every API call (optional). Defaults to the global
+	// OpenTelemetry TracerProvider
+	TracerProvider trace.TracerProvider
+	// Propagates trace context to the API via request headers (optional). Defaults to the global
+	// OpenTelemetry TextMapPropagator
+	Propagator propagation.TextMapPropagator
+	// Client-side rate and concurrency limits (optional). Requests are not throttled by default
+	RateLimit *RateLimit
+	// Fail fast while an endpoint group is failing (optional). Disabled by default
+	CircuitBreaker *CircuitBreaker
+	// Runs around every API call, with the first Middleware being the outermost (optional)
+	Middleware []Middleware
+	// Receives latency, status and retry measurements for every API call and token refresh (optional).
+	// Defaults to `NopMetricsRecorder()`
+	Metrics MetricsRecorder
+	// Structured logger for SDK log output (optional). The standard library's global logger is never used
+	Logger Logger
+	// Enable debug logging to stderr when no Logger is provided
 	Debug bool
+	// Fetch the first auth token on the first API call, instead of in NewClient
+	LazyAuth bool
+
+	// HTTPClient created by NewClient from Timeout, replaced if the Config is used again
+	defaultHTTPClient *http.Client
+}
+
+// Returns a copy of the Config that options can modify without affecting the original
+func (cfg *Config) clone() *Config {
+	c := *cfg
+	c.Middleware = slices.Clone(cfg.Middleware)
+	return &c
 }
 
 // Client is the top-level client containing all APIs
@@ -55,8 +100,24 @@ type Client struct {
 	httpClient *http.Client
 	// Set custom `user-agent` header string
 	userAgent string
-	// Cached Auth token data
-	*TokenCache
+	// Creates a span for every API call
+	tracer trace.Tracer
+	// Injects trace context into request headers
+	propagator propagation.TextMapPropagator
+	// Storage for the Auth token, Config.TokenStore or a MemoryTokenStore of the Client's own
+	tokenStore TokenStore
+	// Throttles requests according to Config.RateLimit
+	limiter *rateLimiter
+	// Sends API calls through the Config.Middleware chain
+	handler Handler
+	// Circuit breakers for each endpoint group, according to Config.CircuitBreaker
+	breakers *circuitBreakers
+	// Local copy of the Auth token data from the TokenStore
+	token *TokenCache
+	// Guards the local token and the in-flight refresh
+	tokenMu sync.Mutex
+	// Pending token refresh shared by all concurrent callers
+	refreshing *tokenRefresh
 	// All available Rize API services
 	Adjustments         *adjustmentService
 	Auth                *authService
@@ -81,51 +142,61 @@ type Client struct {
 
 // TokenCache stores Auth token data
 type TokenCache struct {
-	Token     string
-	Timestamp int64
-}
-
-// Error is the default API error format
-type Error struct {
-	Errors []*ErrorDetails `json:"errors"`
-	Status int             `json:"status"`
-}
-
-// ErrorDetails from the error response
-type ErrorDetails struct {
-	Code       int       `json:"code,omitempty"`
-	Title      string    `json:"title,omitempty"`
-	Detail     string    `json:"detail,omitempty"`
-	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	Token string `json:"token"`
+	// Program and API base URL the token was issued for. Tokens issued for another program or
+	// environment are ignored
+	ProgramUID string `json:"program_uid"`
+	BaseURL    string `json:"base_url"`
+	// Unix time at which the token was fetched
+	Timestamp int64 `json:"timestamp"`
+	// Unix time at which the token expires
+	ExpiresAt int64 `json:"expires_at"`
 }
 
-// Format error output
-func (e *Error) Error() string {
-	var sb strings.Builder
-	sb.WriteString(fmt.Sprintln("Rize API Error", e.Status))
-	for _, v := range e.Errors {
-		sb.WriteString(fmt.Sprintf("%+v", v))
+// NewClient initializes the Client and all services with the given options. A *Config may be passed
+// as an option, e.g. `NewClient(&rize.Config{...})`, and combined with further options:
+//
+//	rc, err := rize.NewClient(
+//		rize.WithCredentials(programUID, hmacKey),
+//		rize.WithEnvironment("sandbox"),
+//		rize.WithLazyAuth(),
+//	)
+func NewClient(opts ...Option) (*Client, error) {
+	// A leading *Config on its own is used as-is, so the defaults are filled in on the caller's
+	// Config. Further options are applied to a copy, leaving the caller's Config unchanged
+	cfg := &Config{}
+	if len(opts) > 0 {
+		if c, ok := opts[0].(*Config); ok && c != nil {
+			cfg, opts = c, opts[1:]
+			if len(opts) > 0 {
+				cfg = c.clone()
+			}
+		}
+	}
+	for _, opt := range opts {
+		opt.apply(cfg)
 	}
-	return sb.String()
-}
-
-// NewClient initializes the Client and all services
-func NewClient(cfg *Config) (*Client, error) {
-	// Enable debug logging
-	internal.EnableLogging(cfg.Debug)
-
-	log.Println("Creating client...")
 
 	// Validate client config
 	if err := cfg.validateConfig(); err != nil {
 		return nil, err
 	}
 
+	cfg.Logger.Debug("Creating client", "environment", cfg.Environment)
+
 	rc := &Client{}
 	rc.cfg = cfg
 	rc.httpClient = cfg.HTTPClient
 	rc.userAgent = fmt.Sprintf("%s/%s (Go: %s)", "rize-go-sdk", internal.SDKVersion, runtime.Version())
-	rc.TokenCache = &TokenCache{}
+	rc.tracer = internal.Tracer(cfg.TracerProvider)
+	rc.propagator = internal.Propagator(cfg.Propagator)
+	rc.tokenStore = cfg.TokenStore
+	if rc.tokenStore == nil {
+		rc.tokenStore = NewMemoryTokenStore()
+	}
+	rc.limiter = newRateLimiter(cfg.RateLimit)
+	rc.breakers = newCircuitBreakers(cfg.CircuitBreaker, cfg.Clock, cfg.Logger)
+	rc.handler = chain(rc.send, cfg.Middleware)
 
 	// Initialize API Services
 	rc.Adjustments = &adjustmentService{client: rc}
@@ -148,40 +219,177 @@ func NewClient(cfg *Config) (*Client, error) {
 	rc.Transactions = &transactionService{client: rc}
 	rc.Transfers = &transferService{client: rc}
 
-	// Generate Auth Token
-	_, err := rc.Auth.GetToken(context.Background())
-	if err != nil {
-		return nil, err
+	// Generate Auth Token, unless it is fetched on the first API call
+	if !cfg.LazyAuth {
+		if _, err := rc.Auth.GetToken(context.Background()); err != nil {
+			return nil, err
+		}
 	}
 
 	return rc, nil
 }
 
-// Make the API request and return an http.Response. Checks for valid auth token.
-func (rc *Client) doRequest(ctx context.Context, method string, path string, query url.Values, data io.Reader) (*http.Response, error) {
-	// Check for valid auth token and refresh if necessary
-	if path != "auth" {
-		if _, err := rc.Auth.GetToken(ctx); err != nil {
+// Make the API call through the middleware chain, decoding the response body into out (if not nil).
+// params are the typed params of the call, which are only passed to the middleware
+func (rc *Client) doRequest(ctx context.Context, op *Operation, params interface{}, query url.Values, data io.Reader, out interface{}, opts ...CallOption) (res *http.Response, err error) {
+	co := newCallOptions(opts)
+	op.noRetry = co.noRetry
+	if co.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, co.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	ctx, span := rc.startSpan(ctx, op)
+	defer func() {
+		endSpan(span, op, res, err)
+		rc.recordRequest(op, start, res, err)
+	}()
+
+	req := &Request{
+		Operation: op,
+		Params:    params,
+		Query:     query,
+		Header:    co.header,
+		Result:    out,
+	}
+	if data != nil {
+		if req.Body, err = io.ReadAll(data); err != nil {
 			return nil, err
 		}
 	}
 
-	url := fmt.Sprintf("%s/%s/%s", rc.cfg.BaseURL, internal.BasePath, path)
+	resp, err := rc.handler(ctx, req)
+	if err == nil && resp == nil {
+		err = fmt.Errorf("rize: middleware returned no response for %s", op.Name)
+	}
+	if resp != nil {
+		resp.ensureHTTPResponse()
+	}
+	for _, dst := range co.responses {
+		captureResponse(dst, resp, err, op, time.Since(start))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Middleware may return a response without decoding it
+	if resp.Result == nil && out != nil && len(resp.Body) > 0 {
+		if err = json.Unmarshal(resp.Body, out); err != nil {
+			return resp.HTTPResponse, err
+		}
+	}
 
-	log.Printf("Sending %s request to %s\n", method, url)
+	return resp.HTTPResponse, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, data)
+// Send the request to the API. This is the innermost Handler of the middleware chain
+func (rc *Client) send(ctx context.Context, req *Request) (*Response, error) {
+	// Check for valid auth token and refresh if necessary
+	token, err := rc.Auth.GetToken(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("User-Agent", rc.userAgent)
-	req.Header.Add("Authorization", rc.TokenCache.Token)
-	req.URL.RawQuery = query.Encode()
+	res, err := rc.sendRequest(ctx, req.Operation, req.Query, req.Body, req.Header, token.Token)
+
+	// The token may have been revoked before it expired. Fetch a new token and replay the request once
+	var rerr *Error
+	if errors.As(err, &rerr) && rerr.Status == http.StatusUnauthorized {
+		rc.cfg.Logger.Info("Auth token was rejected, fetching new token", "operation", req.Operation.Name)
 
-	res, err := rc.httpClient.Do(req)
+		rc.Auth.invalidateToken(ctx, token.Token)
+		if token, err = rc.Auth.GetToken(ctx); err != nil {
+			return nil, err
+		}
+
+		res, err = rc.sendRequest(ctx, req.Operation, req.Query, req.Body, req.Header, token.Token)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Buffer the response body, so it remains readable for callers of the raw response
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	resp := newResponse(res, body)
+	if req.Result != nil {
+		// Responses without content (e.g. 204) leave the result untouched
+		if len(body) > 0 {
+			if err = json.Unmarshal(body, req.Result); err != nil {
+				return nil, err
+			}
+		}
+		resp.Result = req.Result
+	}
+
+	return resp, nil
+}
+
+// Send the API request with the given authorization token, retrying transient failures
+func (rc *Client) sendRequest(ctx context.Context, op *Operation, query url.Values, body []byte, header http.Header, authorization string) (*http.Response, error) {
+	url := fmt.Sprintf("%s/%s/%s", rc.cfg.BaseURL, internal.BasePath, op.Path)
+
+	var (
+		res *http.Response
+		err error
+	)
+	for attempt := 1; ; attempt++ {
+		op.attempts++
+		rc.cfg.Logger.Debug("Sending request", "method", op.Method, "url", url, "attempt", attempt)
+
+		// Fail fast while the endpoint group's circuit is open
+		var record func(*http.Response, error)
+		if record, err = rc.breakers.allow(ctx, op.Group()); err != nil {
+			return nil, err
+		}
+
+		// Wait for the rate limiter before each attempt
+		var release func(*http.Response)
+		if release, err = rc.limiter.acquire(ctx, op.Group()); err != nil {
+			record(nil, nil)
+			return nil, err
+		}
+
+		var req *http.Request
+		req, err = rc.newRequest(ctx, op.Method, url, query, body, header, authorization)
+		if err != nil {
+			release(nil)
+			record(nil, nil)
+			return nil, err
+		}
+
+		res, err = rc.httpClient.Do(req)
+		release(res)
+		record(res, err)
+		if attempt >= rc.cfg.RetryPolicy.MaxAttempts || op.noRetry || !rc.cfg.RetryPolicy.shouldRetry(ctx, req, res, err) {
+			break
+		}
+
+		// Give up rather than wait longer than MaxBackoff
+		delay, ok := rc.cfg.RetryPolicy.delay(attempt, res)
+		if !ok {
+			break
+		}
+		if res != nil {
+			// Drain the discarded response so the connection can be reused
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+			rc.cfg.Logger.Warn("Request failed, retrying", "method", op.Method, "url", url, "status", res.StatusCode, "attempt", attempt, "delay", delay)
+		} else {
+			rc.cfg.Logger.Warn("Request failed, retrying", "method", op.Method, "url", url, "error", err, "attempt", attempt, "delay", delay)
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -193,17 +401,40 @@ func (rc *Client) doRequest(ctx context.Context, method string, path string, que
 		if err != nil {
 			return nil, err
 		}
-		var errorOut = &Error{}
-		if err = json.Unmarshal(body, &errorOut); err != nil {
-			return nil, err
-		}
 		// Use RizeError type to handle specific error codes from the API server
-		return nil, errorOut
+		return nil, newError(res, body)
 	}
 
 	return res, nil
 }
 
+// Build a new http.Request with the default SDK headers
+func (rc *Client) newRequest(ctx context.Context, method string, endpoint string, query url.Values, body []byte, header http.Header, authorization string) (*http.Request, error) {
+	var data io.Reader
+	if body != nil {
+		data = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, data)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", rc.userAgent)
+	req.Header.Add("Authorization", authorization)
+	// Headers added by call options and middleware
+	for key, values := range header {
+		req.Header[http.CanonicalHeaderKey(key)] = values
+	}
+	// Propagate the trace context of the current span
+	rc.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	req.URL.RawQuery = query.Encode()
+
+	return req, nil
+}
+
 // Make sure that we have the proper configuration variables
 func (cfg *Config) validateConfig() error {
 	if cfg.ProgramUID == "" {
@@ -214,15 +445,41 @@ func (cfg *Config) validateConfig() error {
 		return fmt.Errorf("Config error: HMACKey is required")
 	}
 
+	cfg.Logger = internal.DefaultLogger(cfg.Logger, cfg.Debug)
+
 	if ok := slices.Contains(internal.Environments, strings.ToLower(cfg.Environment)); !ok {
-		log.Printf("Environment %s not recognized. Defaulting to sandbox...\n", cfg.Environment)
+		cfg.Logger.Warn("Environment not recognized, defaulting to sandbox", "environment", cfg.Environment)
 		cfg.Environment = "sandbox"
 	}
 
-	if cfg.HTTPClient == nil {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = internal.APITimeout
+	}
+
+	// Also replace the HTTPClient created for an earlier Client, which may use another Timeout
+	if cfg.HTTPClient == nil || cfg.HTTPClient == cfg.defaultHTTPClient {
 		cfg.HTTPClient = &http.Client{
-			Timeout: internal.APITimeout,
+			Timeout: cfg.Timeout,
 		}
+		cfg.defaultHTTPClient = cfg.HTTPClient
+	}
+
+	if cfg.RetryPolicy == nil {
+		cfg.RetryPolicy = DefaultRetryPolicy()
+	} else {
+		cfg.RetryPolicy = cfg.RetryPolicy.withDefaults()
+	}
+
+	if cfg.TokenRefreshMargin <= 0 {
+		cfg.TokenRefreshMargin = internal.TokenRefreshMargin
+	}
+
+	if cfg.Clock == nil {
+		cfg.Clock = time.Now
+	}
+
+	if cfg.Metrics == nil {
+		cfg.Metrics = NopMetricsRecorder()
 	}
 
 	if cfg.BaseURL == "" {
@@ -1,10 +1,11 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"github.com/joho/godotenv"
-	"github.com/rizefinance/rize-go-sdk/internal"
+	"github.com/rizefinance/rize-go-sdk/config"
 	"github.com/rizefinance/rize-go-sdk/mq"
 )
 
@@ -16,16 +17,18 @@ func init() {
 }
 
 func main() {
-	config := mq.Config{
-		Username:    internal.CheckEnvVariable("mq_username"),
-		Password:    internal.CheckEnvVariable("mq_password"),
-		ClientID:    internal.CheckEnvVariable("mq_client_id"),
-		Environment: internal.CheckEnvVariable("environment"),
-		Debug:       true,
+	profile, err := config.Load(context.Background(), nil)
+	if err != nil {
+		log.Fatal("Error loading configuration\n", err)
+	}
+	cfg, err := profile.MQConfig()
+	if err != nil {
+		log.Fatal("Error loading configuration\n", err)
 	}
+	cfg.Debug = true
 
 	// Create new Rize MQ client
-	rc, err := mq.NewClient(&config)
+	rc, err := mq.NewClient(cfg)
 	if err != nil {
 		log.Fatal("Error building MQ client\n", err)
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -8,8 +9,8 @@ import (
 
 	"github.com/joho/godotenv"
 	"github.com/rizefinance/rize-go-sdk"
+	"github.com/rizefinance/rize-go-sdk/config"
 	"github.com/rizefinance/rize-go-sdk/examples"
-	"github.com/rizefinance/rize-go-sdk/internal"
 )
 
 func init() {
@@ -48,13 +49,17 @@ func main() {
 	}
 
 	// Create new Rize client for examples
-	config := rize.Config{
-		ProgramUID:  internal.CheckEnvVariable("program_uid"),
-		HMACKey:     internal.CheckEnvVariable("hmac_key"),
-		Environment: internal.CheckEnvVariable("environment"),
-		Debug:       true,
+	profile, err := config.Load(context.Background(), nil)
+	if err != nil {
+		log.Fatal("Error loading configuration\n", err)
+	}
+	cfg, err := profile.RizeConfig()
+	if err != nil {
+		log.Fatal("Error loading configuration\n", err)
 	}
-	rc, err := rize.NewClient(&config)
+	cfg.Debug = true
+
+	rc, err := rize.NewClient(cfg)
 	if err != nil {
 		log.Fatal("Error building RizeClient\n", err)
 	}
@@ -5,9 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -29,11 +27,11 @@ type Workflow struct {
 
 // WorkflowSummary contains a status summary of the Compliance Workflow
 type WorkflowSummary struct {
-	AcceptedQuantity int       `json:"accepted_quantity,omitempty"`
-	BegunAt          time.Time `json:"begun_at,omitempty"`
-	CompletedStep    int       `json:"completed_step,omitempty"`
-	CurrentStep      int       `json:"current_step,omitempty"`
-	Status           string    `json:"status,omitempty"`
+	AcceptedQuantity int            `json:"accepted_quantity,omitempty"`
+	BegunAt          NullTime       `json:"begun_at"`
+	CompletedStep    int            `json:"completed_step,omitempty"`
+	CurrentStep      int            `json:"current_step,omitempty"`
+	Status           WorkflowStatus `json:"status,omitempty"`
 }
 
 // WorkflowCustomer contains Customer information related to this Compliance Workflow
@@ -45,14 +43,14 @@ type WorkflowCustomer struct {
 
 // WorkflowAcceptedDocument contains information about accepted Compliance Workflow documents
 type WorkflowAcceptedDocument struct {
-	ElectronicSignatureRequired string    `json:"electronic_signature_required,omitempty"`
-	ExternalStorageName         string    `json:"external_storage_name,omitempty"`
-	ComplianceDocumentURL       string    `json:"compliance_document_url,omitempty"`
-	Name                        string    `json:"name,omitempty"`
-	Step                        int       `json:"step,omitempty"`
-	Version                     int       `json:"version,omitempty"`
-	UID                         string    `json:"uid,omitempty"`
-	AcceptedAt                  time.Time `json:"accepted_at,omitempty"`
+	ElectronicSignatureRequired string   `json:"electronic_signature_required,omitempty"`
+	ExternalStorageName         string   `json:"external_storage_name,omitempty"`
+	ComplianceDocumentURL       string   `json:"compliance_document_url,omitempty"`
+	Name                        string   `json:"name,omitempty"`
+	Step                        int      `json:"step,omitempty"`
+	Version                     int      `json:"version,omitempty"`
+	UID                         string   `json:"uid,omitempty"`
+	AcceptedAt                  NullTime `json:"accepted_at"`
 }
 
 // WorkflowPendingDocument contains information about pending Compliance Workflow documents
@@ -78,11 +76,11 @@ type WorkflowDocument struct {
 
 // WorkflowListParams builds the query parameters used in querying Compliance Workflows
 type WorkflowListParams struct {
-	CustomerUID string `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
-	ProductUID  string `url:"product_uid,omitempty" json:"product_uid,omitempty"`
-	InProgress  bool   `url:"in_progress,omitempty" json:"in_progress,omitempty"`
-	Limit       int    `url:"limit,omitempty" json:"limit,omitempty"`
-	Offset      int    `url:"offset,omitempty" json:"offset,omitempty"`
+	CustomerUID string         `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
+	ProductUID  string         `url:"product_uid,omitempty" json:"product_uid,omitempty"`
+	InProgress  Optional[bool] `url:"in_progress,omitempty" json:"in_progress,omitempty"`
+	Limit       int            `url:"limit,omitempty" json:"limit,omitempty"`
+	Offset      int            `url:"offset,omitempty" json:"offset,omitempty"`
 }
 
 // WorkflowLatestParams builds the query parameters used in querying the latest Compliance Workflow for a customer
@@ -119,34 +117,48 @@ type WorkflowListResponse struct {
 }
 
 // Retrieves a list of Compliance Workflows filtered by the given parameters
-func (c *complianceWorkflowService) List(ctx context.Context, params *WorkflowListParams) (*WorkflowListResponse, error) {
+func (c *complianceWorkflowService) List(ctx context.Context, params *WorkflowListParams, opts ...CallOption) (*WorkflowListResponse, error) {
 	// Build WorkflowListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodGet, "compliance_workflows", v, nil)
-	if err != nil {
+	response := &WorkflowListResponse{}
+	if _, err := c.client.doRequest(ctx, newOperation("ComplianceWorkflows.List", http.MethodGet, "compliance_workflows"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &WorkflowListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Compliance Workflows matching the given parameters, fetching additional pages as needed
+func (c *complianceWorkflowService) Iterate(ctx context.Context, params *WorkflowListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*Workflow] {
+	if params == nil {
+		params = &WorkflowListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*Workflow, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := c.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Compliance Workflows matching the given parameters across all pages
+func (c *complianceWorkflowService) ListAll(ctx context.Context, params *WorkflowListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*Workflow, error) {
+	return c.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Associates a new Compliance Workflow and set of Compliance Documents (for acknowledgment) with a Customer
-func (c *complianceWorkflowService) Create(ctx context.Context, params *WorkflowCreateParams) (*Workflow, error) {
+func (c *complianceWorkflowService) Create(ctx context.Context, params *WorkflowCreateParams, opts ...CallOption) (*Workflow, error) {
 	if params.CustomerUID == "" || params.ProductCompliancePlanUID == "" {
 		return nil, fmt.Errorf("CustomerUID and ProductCompliancePlanUID values are required")
 	}
@@ -156,19 +168,8 @@ func (c *complianceWorkflowService) Create(ctx context.Context, params *Workflow
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPost, "compliance_workflows", nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Workflow{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("ComplianceWorkflows.Create", http.MethodPost, "compliance_workflows"), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -177,7 +178,7 @@ func (c *complianceWorkflowService) Create(ctx context.Context, params *Workflow
 
 // ViewLatest is a helper endpoint for retrieving the most recent Compliance Workflow for a Customer.
 // A Customer UID must be supplied as the path parameter.
-func (c *complianceWorkflowService) ViewLatest(ctx context.Context, customerUID string, params *WorkflowLatestParams) (*Workflow, error) {
+func (c *complianceWorkflowService) ViewLatest(ctx context.Context, customerUID string, params *WorkflowLatestParams, opts ...CallOption) (*Workflow, error) {
 	if customerUID == "" {
 		return nil, fmt.Errorf("customerUID is required")
 	}
@@ -187,19 +188,8 @@ func (c *complianceWorkflowService) ViewLatest(ctx context.Context, customerUID
 	if err != nil {
 		return nil, err
 	}
-	res, err := c.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("compliance_workflows/latest/%s", customerUID), v, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Workflow{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("ComplianceWorkflows.ViewLatest", http.MethodGet, "compliance_workflows/latest/{customer_uid}", customerUID), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -207,7 +197,7 @@ func (c *complianceWorkflowService) ViewLatest(ctx context.Context, customerUID
 }
 
 // AcknowledgeDocument is used to indicate acceptance or rejection of a Compliance Document within a given Compliance Workflow
-func (c *complianceWorkflowService) AcknowledgeDocument(ctx context.Context, uid string, params *WorkflowDocumentParams) (*Workflow, error) {
+func (c *complianceWorkflowService) AcknowledgeDocument(ctx context.Context, uid string, params *WorkflowDocumentParams, opts ...CallOption) (*Workflow, error) {
 	if uid == "" || params.Accept == "" || params.DocumentUID == "" || params.CustomerUID == "" {
 		return nil, fmt.Errorf("UID, Accept, DocumentUID and CustomerUID values are required")
 	}
@@ -217,19 +207,8 @@ func (c *complianceWorkflowService) AcknowledgeDocument(ctx context.Context, uid
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("compliance_workflows/%s/acknowledge_document", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Workflow{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("ComplianceWorkflows.AcknowledgeDocument", http.MethodPut, "compliance_workflows/{uid}/acknowledge_document", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -237,7 +216,7 @@ func (c *complianceWorkflowService) AcknowledgeDocument(ctx context.Context, uid
 }
 
 // BatchAcknowledgeDocuments is used to indicate acceptance or rejection of multiple Compliance Documents within a given Compliance Workflow
-func (c *complianceWorkflowService) BatchAcknowledgeDocuments(ctx context.Context, uid string, params *WorkflowBatchDocumentsParams) (*Workflow, error) {
+func (c *complianceWorkflowService) BatchAcknowledgeDocuments(ctx context.Context, uid string, params *WorkflowBatchDocumentsParams, opts ...CallOption) (*Workflow, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
@@ -253,19 +232,8 @@ func (c *complianceWorkflowService) BatchAcknowledgeDocuments(ctx context.Contex
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("compliance_workflows/%s/batch_acknowledge_documents", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Workflow{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("ComplianceWorkflows.BatchAcknowledgeDocuments", http.MethodPut, "compliance_workflows/{uid}/batch_acknowledge_documents", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,291 @@
+// Package config loads rize.Config and mq.Config values from environment variables, a YAML or JSON
+// profile file with named environments, and an optional SecretProvider
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/rizefinance/rize-go-sdk"
+	"github.com/rizefinance/rize-go-sdk/internal"
+	"github.com/rizefinance/rize-go-sdk/mq"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by Load. Upper case variants (e.g. `PROGRAM_UID`) are also accepted. The
+// lower case variable takes precedence; when it is unset or empty, the upper case variant is used.
+// Empty variables never override the profile file
+const (
+	EnvProgramUID  = "program_uid"
+	EnvHMACKey     = "hmac_key"
+	EnvEnvironment = "environment"
+	EnvBaseURL     = "base_url"
+	EnvMQUsername  = "mq_username"
+	EnvMQPassword  = "mq_password"
+	EnvMQClientID  = "mq_client_id"
+	// Enables debug logging when set to a boolean such as `true` or `1`
+	EnvDebug = "debug"
+	// Path of the profile file, when Options.File is not set
+	EnvFile = "rize_config_file"
+	// Name of the profile to load, when Options.Profile is not set
+	EnvProfile = "rize_profile"
+)
+
+// Default secret names passed to the SecretProvider
+const (
+	SecretHMACKey    = "hmac_key"
+	SecretMQPassword = "mq_password"
+)
+
+// SecretProvider fetches secrets, such as the HMAC key and MQ password, from an external store (e.g. a
+// cloud secret manager or vault)
+type SecretProvider interface {
+	// GetSecret returns the value of the named secret
+	GetSecret(ctx context.Context, name string) (string, error)
+}
+
+// SecretProviderFunc adapts a function to the SecretProvider interface
+type SecretProviderFunc func(ctx context.Context, name string) (string, error)
+
+// GetSecret calls fn(ctx, name)
+func (fn SecretProviderFunc) GetSecret(ctx context.Context, name string) (string, error) {
+	return fn(ctx, name)
+}
+
+// Profile holds the configuration values of a single named environment
+type Profile struct {
+	// Name of the profile in the profile file, if any
+	Name string `yaml:"-"`
+	// Program within the target environment
+	ProgramUID string `yaml:"program_uid"`
+	// HMAC key for the target environment
+	HMACKey string `yaml:"hmac_key" redact:"true"`
+	// Rize infrastructure target environment
+	Environment string `yaml:"environment"`
+	// API base URL override
+	BaseURL string `yaml:"base_url"`
+	// Enable debug logging
+	Debug bool `yaml:"debug"`
+	// Message queue username
+	MQUsername string `yaml:"mq_username"`
+	// Message queue password
+	MQPassword string `yaml:"mq_password" redact:"true"`
+	// Message queue topic
+	MQClientID string `yaml:"mq_client_id"`
+	// Secret name of the HMAC key, fetched from the SecretProvider when no HMAC key is set. Defaults to `hmac_key`
+	HMACKeySecret string `yaml:"hmac_key_secret"`
+	// Secret name of the MQ password, fetched from the SecretProvider when no password is set. Defaults to `mq_password`
+	MQPasswordSecret string `yaml:"mq_password_secret"`
+}
+
+// Format masks the HMAC key and MQ password when the Profile is printed
+func (p Profile) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, p)
+}
+
+// Contents of a profile file
+type file struct {
+	// Profile used when none is selected
+	Default  string              `yaml:"default"`
+	Profiles map[string]*Profile `yaml:"profiles"`
+}
+
+// Options configures Load
+type Options struct {
+	// Path of a YAML or JSON profile file (optional). Defaults to the `rize_config_file` environment variable
+	File string
+	// Name of the profile to load from the file (optional). Defaults to the `rize_profile` environment
+	// variable, then to the file's `default` profile
+	Profile string
+	// Fetches secrets that are not set in the file or environment (optional)
+	Secrets SecretProvider
+	// Looks up environment variables (optional). Defaults to `os.LookupEnv`
+	LookupEnv func(key string) (string, bool)
+}
+
+// Load builds a Profile from the profile file, then applies environment variables, which take
+// precedence, and finally fetches missing secrets from the SecretProvider. A missing profile file or
+// profile is returned as an error
+func Load(ctx context.Context, opts *Options) (*Profile, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	lookup := opts.LookupEnv
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	env := func(key string) string {
+		if value, _ := lookup(key); value != "" {
+			return value
+		}
+		value, _ := lookup(strings.ToUpper(key))
+		return value
+	}
+
+	path := opts.File
+	if path == "" {
+		path = env(EnvFile)
+	}
+	name := opts.Profile
+	if name == "" {
+		name = env(EnvProfile)
+	}
+
+	p := &Profile{}
+	if path != "" {
+		var err error
+		if p, err = loadFile(path, name); err != nil {
+			return nil, err
+		}
+	} else if name != "" {
+		return nil, fmt.Errorf("config: profile %q requires a profile file", name)
+	}
+
+	// Environment variables override the profile file
+	for key, field := range map[string]*string{
+		EnvProgramUID:  &p.ProgramUID,
+		EnvHMACKey:     &p.HMACKey,
+		EnvEnvironment: &p.Environment,
+		EnvBaseURL:     &p.BaseURL,
+		EnvMQUsername:  &p.MQUsername,
+		EnvMQPassword:  &p.MQPassword,
+		EnvMQClientID:  &p.MQClientID,
+	} {
+		if value := env(key); value != "" {
+			*field = value
+		}
+	}
+	if value := env(EnvDebug); value != "" {
+		debug, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("config: invalid %s value %q", EnvDebug, value)
+		}
+		p.Debug = debug
+	}
+
+	if opts.Secrets != nil {
+		if err := p.fetchSecrets(ctx, opts.Secrets); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// Reads the named profile from a YAML or JSON file
+func loadFile(path string, name string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: reading profile file: %w", err)
+	}
+
+	// JSON is a subset of YAML, so both formats are decoded the same way
+	f := &file{}
+	if err := yaml.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("config: parsing profile file %s: %w", path, err)
+	}
+
+	if name == "" {
+		name = f.Default
+	}
+	if name == "" && len(f.Profiles) == 1 {
+		for n := range f.Profiles {
+			name = n
+		}
+	}
+	if name == "" {
+		return nil, fmt.Errorf("config: no profile selected in %s", path)
+	}
+
+	p, ok := f.Profiles[name]
+	if !ok || p == nil {
+		return nil, fmt.Errorf("config: profile %q not found in %s", name, path)
+	}
+	p.Name = name
+
+	return p, nil
+}
+
+// Fetches the HMAC key and MQ password, if they are not set and the profile uses them. The HMAC key is
+// used by profiles with a Program UID, the MQ password by profiles with an MQ username. Setting the
+// secret name explicitly also fetches the secret
+func (p *Profile) fetchSecrets(ctx context.Context, secrets SecretProvider) error {
+	var err error
+	if p.HMACKey == "" && (p.ProgramUID != "" || p.HMACKeySecret != "") {
+		if p.HMACKey, err = secrets.GetSecret(ctx, secretName(p.HMACKeySecret, SecretHMACKey)); err != nil {
+			return fmt.Errorf("config: fetching HMAC key: %w", err)
+		}
+	}
+	if p.MQPassword == "" && (p.MQUsername != "" || p.MQPasswordSecret != "") {
+		if p.MQPassword, err = secrets.GetSecret(ctx, secretName(p.MQPasswordSecret, SecretMQPassword)); err != nil {
+			return fmt.Errorf("config: fetching MQ password: %w", err)
+		}
+	}
+	return nil
+}
+
+// Returns the configured secret name, or the default
+func secretName(name string, fallback string) string {
+	if name != "" {
+		return name
+	}
+	return fallback
+}
+
+// Returns an error listing the required values that are not set
+func checkRequired(values map[string]string) error {
+	missing := []string{}
+	for key, value := range values {
+		if value == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	slices.Sort(missing)
+	return fmt.Errorf("config: missing %s", strings.Join(missing, ", "))
+}
+
+// RizeConfig returns a rize.Config for the profile, or an error if the Program UID or HMAC key is missing
+func (p *Profile) RizeConfig() (*rize.Config, error) {
+	if err := checkRequired(map[string]string{
+		EnvProgramUID: p.ProgramUID,
+		EnvHMACKey:    p.HMACKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &rize.Config{
+		ProgramUID:  p.ProgramUID,
+		HMACKey:     p.HMACKey,
+		Environment: p.Environment,
+		BaseURL:     p.BaseURL,
+		Debug:       p.Debug,
+	}, nil
+}
+
+// MQConfig returns an mq.Config for the profile, or an error if the MQ username, password or client ID
+// is missing
+func (p *Profile) MQConfig() (*mq.Config, error) {
+	if err := checkRequired(map[string]string{
+		EnvMQUsername: p.MQUsername,
+		EnvMQPassword: p.MQPassword,
+		EnvMQClientID: p.MQClientID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &mq.Config{
+		Username:    p.MQUsername,
+		Password:    p.MQPassword,
+		ClientID:    p.MQClientID,
+		Environment: p.Environment,
+		Debug:       p.Debug,
+	}, nil
+}
@@ -2,11 +2,8 @@ package rize
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -20,21 +17,21 @@ type CustodialAccount struct {
 	ExternalUID            string                          `json:"external_uid,omitempty"`
 	CustomerUID            string                          `json:"customer_uid,omitempty"`
 	PoolUID                string                          `json:"pool_uid,omitempty"`
-	Type                   string                          `json:"type,omitempty"`
+	Type                   CustodialAccountType            `json:"type,omitempty"`
 	Liability              bool                            `json:"liability,omitempty"`
 	Name                   string                          `json:"name,omitempty"`
 	PrimaryAccount         bool                            `json:"primary_account,omitempty"`
-	Status                 string                          `json:"status,omitempty"`
+	Status                 CustodialAccountStatus          `json:"status,omitempty"`
 	AccountErrors          []*CustodialAccountError        `json:"account_errors,omitempty"`
-	NetUSDBalance          string                          `json:"net_usd_balance,omitempty"`
-	NetUSDPendingBalance   string                          `json:"net_usd_pending_balance,omitempty"`
-	NetUSDAvailableBalance string                          `json:"net_usd_available_balance,omitempty"`
+	NetUSDBalance          Money                           `json:"net_usd_balance"`
+	NetUSDPendingBalance   Money                           `json:"net_usd_pending_balance"`
+	NetUSDAvailableBalance Money                           `json:"net_usd_available_balance"`
 	AssetBalances          []*CustodialAccountAssetBalance `json:"asset_balances,omitempty"`
 	AccountNumber          string                          `json:"account_number,omitempty"`
 	AccountNumberMasked    string                          `json:"account_number_masked,omitempty"`
 	RoutingNumber          string                          `json:"routing_number,omitempty"`
-	OpenedAt               time.Time                       `json:"opened_at,omitempty"`
-	ClosedAt               time.Time                       `json:"closed_at,omitempty"`
+	OpenedAt               NullTime                        `json:"opened_at"`
+	ClosedAt               NullTime                        `json:"closed_at"`
 }
 
 // CustodialAccountError provides errors info related to this account
@@ -48,18 +45,18 @@ type CustodialAccountError struct {
 type CustodialAccountAssetBalance struct {
 	AssetQuantity   string `json:"asset_quantity,omitempty"`
 	AssetType       string `json:"asset_type,omitempty"`
-	CurrentUSDValue string `json:"current_usd_value,omitempty"`
+	CurrentUSDValue Money  `json:"current_usd_value"`
 	Debit           bool   `json:"debit,omitempty"`
 }
 
 // CustodialAccountListParams builds the query parameters used in querying Custodial Accounts
 type CustodialAccountListParams struct {
-	CustomerUID string `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
-	ExternalUID string `url:"external_uid,omitempty" json:"external_uid,omitempty"`
-	Limit       int    `url:"limit,omitempty" json:"limit,omitempty"`
-	Offset      int    `url:"offset,omitempty" json:"offset,omitempty"`
-	Liability   bool   `url:"liability,omitempty" json:"liability,omitempty"`
-	Type        string `url:"type,omitempty" json:"type,omitempty"`
+	CustomerUID string               `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
+	ExternalUID string               `url:"external_uid,omitempty" json:"external_uid,omitempty"`
+	Limit       int                  `url:"limit,omitempty" json:"limit,omitempty"`
+	Offset      int                  `url:"offset,omitempty" json:"offset,omitempty"`
+	Liability   Optional[bool]       `url:"liability,omitempty" json:"liability,omitempty"`
+	Type        CustodialAccountType `url:"type,omitempty" json:"type,omitempty"`
 }
 
 // CustodialAccountListResponse is an API response containing a list of Custodial Accounts
@@ -69,51 +66,54 @@ type CustodialAccountListResponse struct {
 }
 
 // List retrieves a list of Custodial Accounts filtered by the given parameters
-func (c *custodialAccountService) List(ctx context.Context, params *CustodialAccountListParams) (*CustodialAccountListResponse, error) {
+func (c *custodialAccountService) List(ctx context.Context, params *CustodialAccountListParams, opts ...CallOption) (*CustodialAccountListResponse, error) {
 	// Build CustodialAccountListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodGet, "custodial_accounts", v, nil)
-	if err != nil {
+	response := &CustodialAccountListResponse{}
+	if _, err := c.client.doRequest(ctx, newOperation("CustodialAccounts.List", http.MethodGet, "custodial_accounts"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &CustodialAccountListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Custodial Accounts matching the given parameters, fetching additional pages as needed
+func (c *custodialAccountService) Iterate(ctx context.Context, params *CustodialAccountListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*CustodialAccount] {
+	if params == nil {
+		params = &CustodialAccountListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*CustodialAccount, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := c.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Custodial Accounts matching the given parameters across all pages
+func (c *custodialAccountService) ListAll(ctx context.Context, params *CustodialAccountListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*CustodialAccount, error) {
+	return c.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Get returns a single Custodial Account
-func (c *custodialAccountService) Get(ctx context.Context, uid string) (*CustodialAccount, error) {
+func (c *custodialAccountService) Get(ctx context.Context, uid string, opts ...CallOption) (*CustodialAccount, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("custodial_accounts/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &CustodialAccount{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("CustodialAccounts.Get", http.MethodGet, "custodial_accounts/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
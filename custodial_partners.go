@@ -2,9 +2,7 @@ package rize
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
@@ -25,20 +23,9 @@ type CustodialPartnerListResponse struct {
 }
 
 // List retrieves a list of CustodialPartners filtered by the given parameters
-func (c *custodialPartnerService) List(ctx context.Context) (*CustodialPartnerListResponse, error) {
-	res, err := c.client.doRequest(ctx, http.MethodGet, "custodial_partners", nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
+func (c *custodialPartnerService) List(ctx context.Context, opts ...CallOption) (*CustodialPartnerListResponse, error) {
 	response := &CustodialPartnerListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("CustodialPartners.List", http.MethodGet, "custodial_partners"), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -46,24 +33,13 @@ func (c *custodialPartnerService) List(ctx context.Context) (*CustodialPartnerLi
 }
 
 // Get returns a single CustodialPartner
-func (c *custodialPartnerService) Get(ctx context.Context, uid string) (*CustodialPartner, error) {
+func (c *custodialPartnerService) Get(ctx context.Context, uid string, opts ...CallOption) (*CustodialPartner, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("custodial_partners/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &CustodialPartner{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("CustodialPartners.Get", http.MethodGet, "custodial_partners/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
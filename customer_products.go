@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -16,13 +15,13 @@ type customerProductService service
 
 // CustomerProduct data type
 type CustomerProduct struct {
-	UID           string `json:"uid,omitempty"`
-	Status        string `json:"status,omitempty"`
-	CustomerUID   string `json:"customer_uid,omitempty"`
-	CustomerEmail string `json:"customer_email,omitempty"`
-	ProductUID    string `json:"product_uid,omitempty"`
-	ProductName   string `json:"product_name,omitempty"`
-	ProgramUID    string `json:"program_uid,omitempty"`
+	UID           string                `json:"uid,omitempty"`
+	Status        CustomerProductStatus `json:"status,omitempty"`
+	CustomerUID   string                `json:"customer_uid,omitempty"`
+	CustomerEmail string                `json:"customer_email,omitempty"`
+	ProductUID    string                `json:"product_uid,omitempty"`
+	ProductName   string                `json:"product_name,omitempty"`
+	ProgramUID    string                `json:"program_uid,omitempty"`
 }
 
 // CustomerProductListParams builds the query parameters used in querying Customer Products
@@ -45,25 +44,14 @@ type CustomerProductListResponse struct {
 }
 
 // List Customers and the Products they have onboarded onto, filtered by the given parameters
-func (cp *customerProductService) List(ctx context.Context, params *CustomerProductListParams) (*CustomerProductListResponse, error) {
+func (cp *customerProductService) List(ctx context.Context, params *CustomerProductListParams, opts ...CallOption) (*CustomerProductListResponse, error) {
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := cp.client.doRequest(ctx, http.MethodGet, "customer_products", v, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &CustomerProductListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := cp.client.doRequest(ctx, newOperation("CustomerProducts.List", http.MethodGet, "customer_products"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -71,7 +59,7 @@ func (cp *customerProductService) List(ctx context.Context, params *CustomerProd
 }
 
 // Create will submit a request to onboard a Customer onto a new product
-func (cp *customerProductService) Create(ctx context.Context, params *CustomerProductCreateParams) (*CustomerProduct, error) {
+func (cp *customerProductService) Create(ctx context.Context, params *CustomerProductCreateParams, opts ...CallOption) (*CustomerProduct, error) {
 	if params.CustomerUID == "" || params.ProductUID == "" {
 		return nil, fmt.Errorf("CustomerUID and ProductUID are required")
 	}
@@ -81,19 +69,8 @@ func (cp *customerProductService) Create(ctx context.Context, params *CustomerPr
 		return nil, err
 	}
 
-	res, err := cp.client.doRequest(ctx, http.MethodPost, "customer_products", nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &CustomerProduct{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := cp.client.doRequest(ctx, newOperation("CustomerProducts.Create", http.MethodPost, "customer_products"), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -101,24 +78,13 @@ func (cp *customerProductService) Create(ctx context.Context, params *CustomerPr
 }
 
 // Get a single Customer Product
-func (cp *customerProductService) Get(ctx context.Context, uid string) (*CustomerProduct, error) {
+func (cp *customerProductService) Get(ctx context.Context, uid string, opts ...CallOption) (*CustomerProduct, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := cp.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("customer_products/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &CustomerProduct{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := cp.client.doRequest(ctx, newOperation("CustomerProducts.Get", http.MethodGet, "customer_products/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
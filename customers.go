@@ -5,12 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	"github.com/google/go-querystring/query"
-	"github.com/rizefinance/rize-go-sdk/internal"
 )
 
 // Handles all Customer related functionality
@@ -20,23 +18,23 @@ type customerService service
 type Customer struct {
 	UID                   string                     `json:"uid,omitempty"`
 	ExternalUID           string                     `json:"external_uid,omitempty"`
-	ActivatedAt           time.Time                  `json:"activated_at,omitempty"`
-	CreatedAt             time.Time                  `json:"created_at,omitempty"`
-	CustomerType          string                     `json:"customer_type,omitempty"`
+	ActivatedAt           NullTime                   `json:"activated_at"`
+	CreatedAt             time.Time                  `json:"created_at"`
+	CustomerType          CustomerType               `json:"customer_type,omitempty"`
 	Email                 string                     `json:"email,omitempty"`
 	Details               *CustomerDetails           `json:"details,omitempty"`
-	KYCStatus             string                     `json:"kyc_status,omitempty"`
+	KYCStatus             KYCStatus                  `json:"kyc_status,omitempty"`
 	KYCStatusReasons      []string                   `json:"kyc_status_reasons,omitempty"`
 	LockReason            string                     `json:"lock_reason,omitempty"`
-	LockedAt              time.Time                  `json:"locked_at,omitempty"`
-	PIIConfirmedAt        time.Time                  `json:"pii_confirmed_at,omitempty"`
+	LockedAt              NullTime                   `json:"locked_at"`
+	PIIConfirmedAt        NullTime                   `json:"pii_confirmed_at"`
 	PoolUIDs              []string                   `json:"pool_uids,omitempty"`
 	PrimaryCustomerUID    string                     `json:"primary_customer_uid,omitempty"`
 	ProfileResponses      []*CustomerProfileResponse `json:"profile_responses,omitempty"`
 	ProgramUID            string                     `json:"program_uid,omitempty"`
 	SecondaryCustomerUIDs []string                   `json:"secondary_customer_uids,omitempty"`
-	Status                string                     `json:"status,omitempty"`
-	TotalBalance          string                     `json:"total_balance,omitempty"`
+	Status                CustomerStatus             `json:"status,omitempty"`
+	TotalBalance          Money                      `json:"total_balance"`
 }
 
 // CustomerDetails is an object containing the supplied identifying information for the Customer
@@ -47,12 +45,20 @@ type CustomerDetails struct {
 	Suffix       string           `json:"suffix,omitempty"`
 	Phone        string           `json:"phone,omitempty"`
 	BusinessName string           `json:"business_name,omitempty"`
-	DOB          internal.DOB     `json:"dob,omitempty"`
+	DOB          Date             `json:"dob,omitempty"`
 	SSN          string           `json:"ssn,omitempty"`
 	SSNLastFour  string           `json:"ssn_last_four,omitempty"`
 	Address      *CustomerAddress `json:"address,omitempty"`
 }
 
+// MarshalJSON leaves an unset DOB out of the request body, so that updating other details does not
+// clear it
+func (d CustomerDetails) MarshalJSON() ([]byte, error) {
+	// Alias without the MarshalJSON method
+	type details CustomerDetails
+	return marshalOmitUnset(details(d))
+}
+
 // CustomerAddress information
 type CustomerAddress struct {
 	Street1    string `json:"street1,omitempty"`
@@ -64,34 +70,34 @@ type CustomerAddress struct {
 
 // CustomerProfileResponse contains Profile Response info
 type CustomerProfileResponse struct {
-	ProfileRequirement    string                                `json:"profile_requirement,omitempty"`
-	ProfileRequirementUID string                                `json:"profile_requirement_uid,omitempty"`
-	ProfileResponse       *internal.CustomerProfileResponseItem `json:"profile_response,omitempty"`
+	ProfileRequirement    string           `json:"profile_requirement,omitempty"`
+	ProfileRequirementUID string           `json:"profile_requirement_uid,omitempty"`
+	ProfileResponse       *ProfileResponse `json:"profile_response,omitempty"`
 }
 
 // CustomerListParams builds the query parameters used in querying Customers
 type CustomerListParams struct {
-	UID              string `url:"uid,omitempty" json:"uid,omitempty"`
-	Status           string `url:"status,omitempty" json:"status,omitempty"`
-	IncludeInitiated bool   `url:"include_initiated,omitempty" json:"include_initiated"`
-	KYCStatus        string `url:"kyc_status,omitempty" json:"kyc_status,omitempty"`
-	CustomerType     string `url:"customer_type,omitempty" json:"customer_type,omitempty"`
-	FirstName        string `url:"first_name,omitempty" json:"first_name,omitempty"`
-	LastName         string `url:"last_name,omitempty" json:"last_name,omitempty"`
-	Email            string `url:"email,omitempty" json:"email,omitempty"`
-	Locked           bool   `url:"locked,omitempty" json:"locked"`
-	ProgramUID       string `url:"program_uid,omitempty" json:"program_uid,omitempty"`
-	BusinessName     string `url:"business_name,omitempty" json:"business_name,omitempty"`
-	ExternalUID      string `url:"external_uid,omitempty" json:"external_uid,omitempty"`
-	PoolUID          string `url:"pool_uid,omitempty" json:"pool_uid,omitempty"`
-	Limit            int    `url:"limit,omitempty" json:"limit"`
-	Offset           int    `url:"offset,omitempty" json:"offset"`
-	Sort             string `url:"sort,omitempty" json:"sort,omitempty"`
+	UID              string         `url:"uid,omitempty" json:"uid,omitempty"`
+	Status           CustomerStatus `url:"status,omitempty" json:"status,omitempty"`
+	IncludeInitiated Optional[bool] `url:"include_initiated,omitempty" json:"include_initiated"`
+	KYCStatus        KYCStatus      `url:"kyc_status,omitempty" json:"kyc_status,omitempty"`
+	CustomerType     CustomerType   `url:"customer_type,omitempty" json:"customer_type,omitempty"`
+	FirstName        string         `url:"first_name,omitempty" json:"first_name,omitempty"`
+	LastName         string         `url:"last_name,omitempty" json:"last_name,omitempty"`
+	Email            string         `url:"email,omitempty" json:"email,omitempty"`
+	Locked           Optional[bool] `url:"locked,omitempty" json:"locked"`
+	ProgramUID       string         `url:"program_uid,omitempty" json:"program_uid,omitempty"`
+	BusinessName     string         `url:"business_name,omitempty" json:"business_name,omitempty"`
+	ExternalUID      string         `url:"external_uid,omitempty" json:"external_uid,omitempty"`
+	PoolUID          string         `url:"pool_uid,omitempty" json:"pool_uid,omitempty"`
+	Limit            int            `url:"limit,omitempty" json:"limit"`
+	Offset           int            `url:"offset,omitempty" json:"offset"`
+	Sort             string         `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // CustomerCreateParams are the body params used when creating a new Customer
 type CustomerCreateParams struct {
-	CustomerType       string           `json:"customer_type,omitempty"`
+	CustomerType       CustomerType     `json:"customer_type,omitempty"`
 	PrimaryCustomerUID string           `json:"primary_customer_uid,omitempty"`
 	ExternalUID        string           `json:"external_uid,omitempty"`
 	Email              string           `json:"email,omitempty"`
@@ -100,9 +106,16 @@ type CustomerCreateParams struct {
 
 // CustomerUpdateParams are the body params used when updating a Customer
 type CustomerUpdateParams struct {
-	Email       string           `json:"email,omitempty"`
+	Email       Optional[string] `json:"email,omitempty"`
 	Details     *CustomerDetails `json:"details,omitempty"`
-	ExternalUID string           `json:"external_uid,omitempty"`
+	ExternalUID Optional[string] `json:"external_uid,omitempty"`
+}
+
+// MarshalJSON leaves unset fields out of the request body
+func (p CustomerUpdateParams) MarshalJSON() ([]byte, error) {
+	// Alias without the MarshalJSON method
+	type params CustomerUpdateParams
+	return marshalOmitUnset(params(p))
 }
 
 // CustomerDeleteParams are the body params used when deleting/archiving a Customer
@@ -119,8 +132,8 @@ type CustomerLockParams struct {
 
 // CustomerProfileResponseParams are the body params used when updating Customer Profile responses
 type CustomerProfileResponseParams struct {
-	ProfileRequirementUID string                                `json:"profile_requirement_uid"`
-	ProfileResponse       *internal.CustomerProfileResponseItem `json:"profile_response"`
+	ProfileRequirementUID string           `json:"profile_requirement_uid"`
+	ProfileResponse       *ProfileResponse `json:"profile_response"`
 }
 
 // CustomerListResponse is an API response containing a list of Customers
@@ -130,81 +143,85 @@ type CustomerListResponse struct {
 }
 
 // List retrieves a list of Customers filtered by the given parameters
-func (c *customerService) List(ctx context.Context, params *CustomerListParams) (*CustomerListResponse, error) {
+func (c *customerService) List(ctx context.Context, params *CustomerListParams, opts ...CallOption) (*CustomerListResponse, error) {
 	// Build CustomerListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodGet, "customers", v, nil)
-	if err != nil {
+	response := &CustomerListResponse{}
+	if _, err := c.client.doRequest(ctx, newOperation("Customers.List", http.MethodGet, "customers"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &CustomerListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Customers matching the given parameters, fetching additional pages as needed
+func (c *customerService) Iterate(ctx context.Context, params *CustomerListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*Customer] {
+	if params == nil {
+		params = &CustomerListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*Customer, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := c.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Customers matching the given parameters across all pages
+func (c *customerService) ListAll(ctx context.Context, params *CustomerListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*Customer, error) {
+	return c.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Create is used to initialize a new Customer with an email and external_uid
-func (c *customerService) Create(ctx context.Context, params *CustomerCreateParams) (*Customer, error) {
-	if params.CustomerType == "secondary" && params.PrimaryCustomerUID == "" {
+// If no ExternalUID is provided, the key set with WithIdempotencyKey is used, or one is generated.
+// The params are not modified; the ExternalUID is returned on the created resource
+func (c *customerService) Create(ctx context.Context, params *CustomerCreateParams, opts ...CallOption) (*Customer, error) {
+	if params.CustomerType == CustomerTypeSecondary && params.PrimaryCustomerUID == "" {
 		return nil, fmt.Errorf("primary_customer_uid is required for secondary customers")
 	}
 
-	bytesMessage, err := json.Marshal(params)
+	p := *params
+	p.ExternalUID = createExternalUID(p.ExternalUID, opts)
+	bytesMessage, err := json.Marshal(&p)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPost, "customers", nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
+	create := func(ctx context.Context, opts ...CallOption) (*Customer, error) {
+		response := &Customer{}
+		_, err := c.client.doRequest(ctx, newOperation("Customers.Create", http.MethodPost, "customers"), &p, nil, bytes.NewBuffer(bytesMessage), response, opts...)
+		return response, err
 	}
-
-	response := &Customer{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+	list := func(ctx context.Context, opts ...CallOption) ([]*Customer, error) {
+		resp, err := c.List(ctx, &CustomerListParams{ExternalUID: p.ExternalUID, IncludeInitiated: Some(true)}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
 	}
 
-	return response, nil
+	return createWithExternalUID(ctx, c.client, opts, create, list)
 }
 
 // Get retrieves overall status about a Customer as well as their total Asset Balances across all accounts
-func (c *customerService) Get(ctx context.Context, uid string) (*Customer, error) {
+func (c *customerService) Get(ctx context.Context, uid string, opts ...CallOption) (*Customer, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("customers/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Customer{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("Customers.Get", http.MethodGet, "customers/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -212,7 +229,7 @@ func (c *customerService) Get(ctx context.Context, uid string) (*Customer, error
 }
 
 // Update will submit or update a Customer's personally identifiable information (PII) after they are created
-func (c *customerService) Update(ctx context.Context, uid string, params *CustomerUpdateParams) (*Customer, error) {
+func (c *customerService) Update(ctx context.Context, uid string, params *CustomerUpdateParams, opts ...CallOption) (*Customer, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
@@ -222,19 +239,8 @@ func (c *customerService) Update(ctx context.Context, uid string, params *Custom
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("customers/%s", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Customer{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("Customers.Update", http.MethodPut, "customers/{uid}", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -242,7 +248,7 @@ func (c *customerService) Update(ctx context.Context, uid string, params *Custom
 }
 
 // Delete will archive a Customer
-func (c *customerService) Delete(ctx context.Context, uid string, params *CustomerDeleteParams) (*http.Response, error) {
+func (c *customerService) Delete(ctx context.Context, uid string, params *CustomerDeleteParams, opts ...CallOption) (*DeleteResult, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
@@ -252,34 +258,23 @@ func (c *customerService) Delete(ctx context.Context, uid string, params *Custom
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodDelete, fmt.Sprintf("customers/%s", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
+	result := &DeleteResult{UID: uid, Response: &Response{}}
+	opts = append(opts, WithResponse(result.Response))
+	if _, err := c.client.doRequest(ctx, newOperation("Customers.Delete", http.MethodDelete, "customers/{uid}", uid), params, nil, bytes.NewBuffer(bytesMessage), nil, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	return res, nil
+	return result, nil
 }
 
 // ConfirmPIIData is used to explicitly confirm a Customer's PII data is up-to-date in order to add additional products
-func (c *customerService) ConfirmPIIData(ctx context.Context, uid string) (*Customer, error) {
+func (c *customerService) ConfirmPIIData(ctx context.Context, uid string, opts ...CallOption) (*Customer, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("customers/%s/identity_confirmation", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Customer{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("Customers.ConfirmPIIData", http.MethodPut, "customers/{uid}/identity_confirmation", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -287,7 +282,7 @@ func (c *customerService) ConfirmPIIData(ctx context.Context, uid string) (*Cust
 }
 
 // Lock will freeze all activities relating to the Customer
-func (c *customerService) Lock(ctx context.Context, uid string, params *CustomerLockParams) (*Customer, error) {
+func (c *customerService) Lock(ctx context.Context, uid string, params *CustomerLockParams, opts ...CallOption) (*Customer, error) {
 	if uid == "" || params.LockReason == "" {
 		return nil, fmt.Errorf("UID and LockReason are required")
 	}
@@ -297,19 +292,8 @@ func (c *customerService) Lock(ctx context.Context, uid string, params *Customer
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("customers/%s/lock", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Customer{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("Customers.Lock", http.MethodPut, "customers/{uid}/lock", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -317,7 +301,7 @@ func (c *customerService) Lock(ctx context.Context, uid string, params *Customer
 }
 
 // Unlock will remove the Customer lock, returning their state to normal
-func (c *customerService) Unlock(ctx context.Context, uid string, params *CustomerLockParams) (*Customer, error) {
+func (c *customerService) Unlock(ctx context.Context, uid string, params *CustomerLockParams, opts ...CallOption) (*Customer, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
@@ -327,19 +311,8 @@ func (c *customerService) Unlock(ctx context.Context, uid string, params *Custom
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("customers/%s/unlock", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Customer{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("Customers.Unlock", http.MethodPut, "customers/{uid}/unlock", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -347,17 +320,20 @@ func (c *customerService) Unlock(ctx context.Context, uid string, params *Custom
 }
 
 // UpdateProfileResponses is used to submit a Customer's Profile Responses to Profile Requirements.
-// For most cases, use CustomerProfileResponseItem.Response to submit a string response.
-// For ordered list type responses, use CustomerProfileResponseItem.Num0/1/2
-func (c *customerService) UpdateProfileResponses(ctx context.Context, uid string, params []*CustomerProfileResponseParams) (*Customer, error) {
+// For most cases, use ProfileResponse.Response to submit a string response.
+// For ordered list type responses, use ProfileResponse.Num0/1/2
+func (c *customerService) UpdateProfileResponses(ctx context.Context, uid string, params []*CustomerProfileResponseParams, opts ...CallOption) (*Customer, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
 	for _, v := range params {
-		if v.ProfileRequirementUID == "" || (v.ProfileResponse.Response == "" && v.ProfileResponse.Num0 == "") {
+		if v.ProfileRequirementUID == "" {
 			return nil, fmt.Errorf("ProfileRequirementUID and ProfileResponse are required")
 		}
+		if err := v.ProfileResponse.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
 	// Wrap profile response params in a `details` json object
@@ -371,19 +347,8 @@ func (c *customerService) UpdateProfileResponses(ctx context.Context, uid string
 		return nil, err
 	}
 
-	res, err := c.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("customers/%s/update_profile_responses", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Customer{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := c.client.doRequest(ctx, newOperation("Customers.UpdateProfileResponses", http.MethodPut, "customers/{uid}/update_profile_responses", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -5,9 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -25,13 +23,13 @@ type DebitCard struct {
 	CustodialAccountUID   string                    `json:"custodial_account_uid,omitempty"`
 	CardArtworkUID        string                    `json:"card_artwork_uid,omitempty"`
 	CardLastFourDigits    string                    `json:"card_last_four_digits,omitempty"`
-	Status                string                    `json:"status,omitempty"`
-	Type                  string                    `json:"type,omitempty"`
+	Status                DebitCardStatus           `json:"status,omitempty"`
+	Type                  DebitCardType             `json:"type,omitempty"`
 	ReadyToUse            bool                      `json:"ready_to_use,omitempty"`
-	LockReason            string                    `json:"lock_reason,omitempty"`
+	LockReason            DebitCardLockReason       `json:"lock_reason,omitempty"`
 	IssuedOn              string                    `json:"issued_on,omitempty"`
-	LockedAt              time.Time                 `json:"locked_at,omitempty"`
-	ClosedAt              time.Time                 `json:"closed_at,omitempty"`
+	LockedAt              NullTime                  `json:"locked_at"`
+	ClosedAt              NullTime                  `json:"closed_at"`
 	LatestShippingAddress *DebitCardShippingAddress `json:"latest_shipping_address,omitempty"`
 }
 
@@ -52,13 +50,13 @@ type DebitCardAccessToken struct {
 
 // DebitCardListParams builds the query parameters used in querying Debit Cards
 type DebitCardListParams struct {
-	CustomerUID string `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
-	ExternalUID string `url:"external_uid,omitempty" json:"external_uid,omitempty"`
-	Limit       int    `url:"limit,omitempty" json:"limit,omitempty"`
-	Offset      int    `url:"offset,omitempty" json:"offset,omitempty"`
-	PoolUID     string `url:"pool_uid,omitempty" json:"pool_uid,omitempty"`
-	Locked      bool   `url:"locked,omitempty" json:"locked,omitempty"`
-	Status      string `url:"status,omitempty" json:"status,omitempty"`
+	CustomerUID string          `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
+	ExternalUID string          `url:"external_uid,omitempty" json:"external_uid,omitempty"`
+	Limit       int             `url:"limit,omitempty" json:"limit,omitempty"`
+	Offset      int             `url:"offset,omitempty" json:"offset,omitempty"`
+	PoolUID     string          `url:"pool_uid,omitempty" json:"pool_uid,omitempty"`
+	Locked      Optional[bool]  `url:"locked,omitempty" json:"locked,omitempty"`
+	Status      DebitCardStatus `url:"status,omitempty" json:"status,omitempty"`
 }
 
 // DebitCardCreateParams are the body params used when creating a new Debit Card
@@ -79,13 +77,13 @@ type DebitCardActivateParams struct {
 
 // DebitCardLockParams are the body params used when locking a Debit Card
 type DebitCardLockParams struct {
-	LockReason string `json:"lock_reason"`
+	LockReason DebitCardLockReason `json:"lock_reason"`
 }
 
 // DebitCardReissueParams are the body params used when reissuing a Debit Card
 type DebitCardReissueParams struct {
 	CardArtworkUID  string                    `json:"card_artwork_uid,omitempty"`
-	ReissueReason   string                    `json:"reissue_reason"`
+	ReissueReason   DebitCardReissueReason    `json:"reissue_reason"`
 	ShippingAddress *DebitCardShippingAddress `json:"shipping_address,omitempty"`
 }
 
@@ -119,81 +117,85 @@ type DebitCardPINTokenResponse struct {
 }
 
 // List retrieves a list of Debit Cards filtered by the given parameters
-func (d *debitCardService) List(ctx context.Context, params *DebitCardListParams) (*DebitCardListResponse, error) {
+func (d *debitCardService) List(ctx context.Context, params *DebitCardListParams, opts ...CallOption) (*DebitCardListResponse, error) {
 	// Build DebitCardListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodGet, "debit_cards", v, nil)
-	if err != nil {
+	response := &DebitCardListResponse{}
+	if _, err := d.client.doRequest(ctx, newOperation("DebitCards.List", http.MethodGet, "debit_cards"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &DebitCardListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Debit Cards matching the given parameters, fetching additional pages as needed
+func (d *debitCardService) Iterate(ctx context.Context, params *DebitCardListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*DebitCard] {
+	if params == nil {
+		params = &DebitCardListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*DebitCard, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := d.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Debit Cards matching the given parameters across all pages
+func (d *debitCardService) ListAll(ctx context.Context, params *DebitCardListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*DebitCard, error) {
+	return d.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Create is used to a new Debit Card and attach it to the supplied Customer and Pool
-func (d *debitCardService) Create(ctx context.Context, params *DebitCardCreateParams) (*DebitCard, error) {
+// If no ExternalUID is provided, the key set with WithIdempotencyKey is used, or one is generated.
+// The params are not modified; the ExternalUID is returned on the created resource
+func (d *debitCardService) Create(ctx context.Context, params *DebitCardCreateParams, opts ...CallOption) (*DebitCard, error) {
 	if params.CustomerUID == "" || params.PoolUID == "" {
 		return nil, fmt.Errorf("CustomerUID and PoolUID are required")
 	}
 
-	bytesMessage, err := json.Marshal(params)
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := d.client.doRequest(ctx, http.MethodPost, "debit_cards", nil, bytes.NewBuffer(bytesMessage))
+	p := *params
+	p.ExternalUID = createExternalUID(p.ExternalUID, opts)
+	bytesMessage, err := json.Marshal(&p)
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
+	create := func(ctx context.Context, opts ...CallOption) (*DebitCard, error) {
+		response := &DebitCard{}
+		_, err := d.client.doRequest(ctx, newOperation("DebitCards.Create", http.MethodPost, "debit_cards"), &p, nil, bytes.NewBuffer(bytesMessage), response, opts...)
+		return response, err
 	}
-
-	response := &DebitCard{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+	list := func(ctx context.Context, opts ...CallOption) ([]*DebitCard, error) {
+		resp, err := d.List(ctx, &DebitCardListParams{ExternalUID: p.ExternalUID}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
 	}
 
-	return response, nil
+	return createWithExternalUID(ctx, d.client, opts, create, list)
 }
 
 // Get returns a single DebitCard
-func (d *debitCardService) Get(ctx context.Context, uid string) (*DebitCard, error) {
+func (d *debitCardService) Get(ctx context.Context, uid string, opts ...CallOption) (*DebitCard, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("debit_cards/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DebitCard{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := d.client.doRequest(ctx, newOperation("DebitCards.Get", http.MethodGet, "debit_cards/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -201,7 +203,7 @@ func (d *debitCardService) Get(ctx context.Context, uid string) (*DebitCard, err
 }
 
 // Activate a Debit Card
-func (d *debitCardService) Activate(ctx context.Context, uid string, params *DebitCardActivateParams) (*DebitCard, error) {
+func (d *debitCardService) Activate(ctx context.Context, uid string, params *DebitCardActivateParams, opts ...CallOption) (*DebitCard, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
@@ -215,19 +217,8 @@ func (d *debitCardService) Activate(ctx context.Context, uid string, params *Deb
 		return nil, err
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("debit_cards/%s/activate", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DebitCard{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := d.client.doRequest(ctx, newOperation("DebitCards.Activate", http.MethodPut, "debit_cards/{uid}/activate", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -235,7 +226,7 @@ func (d *debitCardService) Activate(ctx context.Context, uid string, params *Deb
 }
 
 // Lock will temporarily lock the Debit Card
-func (d *debitCardService) Lock(ctx context.Context, uid string, params *DebitCardLockParams) (*DebitCard, error) {
+func (d *debitCardService) Lock(ctx context.Context, uid string, params *DebitCardLockParams, opts ...CallOption) (*DebitCard, error) {
 	if uid == "" || params.LockReason == "" {
 		return nil, fmt.Errorf("UID and LockReason are required")
 	}
@@ -245,19 +236,8 @@ func (d *debitCardService) Lock(ctx context.Context, uid string, params *DebitCa
 		return nil, err
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("debit_cards/%s/lock", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DebitCard{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := d.client.doRequest(ctx, newOperation("DebitCards.Lock", http.MethodPut, "debit_cards/{uid}/lock", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -265,24 +245,13 @@ func (d *debitCardService) Lock(ctx context.Context, uid string, params *DebitCa
 }
 
 // Unlock will attempt to remove a lock placed on a Debit Card
-func (d *debitCardService) Unlock(ctx context.Context, uid string) (*DebitCard, error) {
+func (d *debitCardService) Unlock(ctx context.Context, uid string, opts ...CallOption) (*DebitCard, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("debit_cards/%s/unlock", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DebitCard{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := d.client.doRequest(ctx, newOperation("DebitCards.Unlock", http.MethodPut, "debit_cards/{uid}/unlock", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -290,7 +259,7 @@ func (d *debitCardService) Unlock(ctx context.Context, uid string) (*DebitCard,
 }
 
 // Reissue a Debit Card that is lost or stolen, or when it has suffered damage
-func (d *debitCardService) Reissue(ctx context.Context, uid string, params *DebitCardReissueParams) (*DebitCard, error) {
+func (d *debitCardService) Reissue(ctx context.Context, uid string, params *DebitCardReissueParams, opts ...CallOption) (*DebitCard, error) {
 	if uid == "" || params.ReissueReason == "" {
 		return nil, fmt.Errorf("UID and ReissueReason are required")
 	}
@@ -300,19 +269,8 @@ func (d *debitCardService) Reissue(ctx context.Context, uid string, params *Debi
 		return nil, err
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("debit_cards/%s/reissue", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DebitCard{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := d.client.doRequest(ctx, newOperation("DebitCards.Reissue", http.MethodPut, "debit_cards/{uid}/reissue", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -320,7 +278,7 @@ func (d *debitCardService) Reissue(ctx context.Context, uid string, params *Debi
 }
 
 // GetPINToken is used to retrieve a token necessary to change a Debit Card's PIN
-func (d *debitCardService) GetPINToken(ctx context.Context, uid string, params *DebitCardGetPINTokenParams) (*DebitCardPINTokenResponse, error) {
+func (d *debitCardService) GetPINToken(ctx context.Context, uid string, params *DebitCardGetPINTokenParams, opts ...CallOption) (*DebitCardPINTokenResponse, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
@@ -330,19 +288,8 @@ func (d *debitCardService) GetPINToken(ctx context.Context, uid string, params *
 		return nil, err
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("debit_cards/%s/pin_change_token", uid), v, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DebitCardPINTokenResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := d.client.doRequest(ctx, newOperation("DebitCards.GetPINToken", http.MethodGet, "debit_cards/{uid}/pin_change_token", uid), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -350,24 +297,13 @@ func (d *debitCardService) GetPINToken(ctx context.Context, uid string, params *
 }
 
 // GetAccessToken  is used to retrieve the configuration ID and token necessary to retrieve a virtual Debit Card image
-func (d *debitCardService) GetAccessToken(ctx context.Context, uid string) (*DebitCardAccessToken, error) {
+func (d *debitCardService) GetAccessToken(ctx context.Context, uid string, opts ...CallOption) (*DebitCardAccessToken, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("debit_cards/%s/access_token", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DebitCardAccessToken{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := d.client.doRequest(ctx, newOperation("DebitCards.GetAccessToken", http.MethodGet, "debit_cards/{uid}/access_token", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -375,7 +311,7 @@ func (d *debitCardService) GetAccessToken(ctx context.Context, uid string) (*Deb
 }
 
 // MigrateVirtualDebitCard will result in a physical version of the virtual debit card being issued to a Customer
-func (d *debitCardService) MigrateVirtualDebitCard(ctx context.Context, uid string, params *VirtualDebitCardMigrateParams) (*DebitCard, error) {
+func (d *debitCardService) MigrateVirtualDebitCard(ctx context.Context, uid string, params *VirtualDebitCardMigrateParams, opts ...CallOption) (*DebitCard, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
@@ -385,19 +321,8 @@ func (d *debitCardService) MigrateVirtualDebitCard(ctx context.Context, uid stri
 		return nil, err
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("debit_cards/%s/migrate", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DebitCard{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := d.client.doRequest(ctx, newOperation("DebitCards.MigrateVirtualDebitCard", http.MethodPut, "debit_cards/{uid}/migrate", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -405,7 +330,7 @@ func (d *debitCardService) MigrateVirtualDebitCard(ctx context.Context, uid stri
 }
 
 // GetVirtualDebitCardImage is used to retrieve a virtual Debit Card image
-func (d *debitCardService) GetVirtualDebitCardImage(ctx context.Context, params *VirtualDebitCardQueryParams) (*http.Response, error) {
+func (d *debitCardService) GetVirtualDebitCardImage(ctx context.Context, params *VirtualDebitCardQueryParams, opts ...CallOption) (*http.Response, error) {
 	if params.Config == "" || params.Token == "" {
 		return nil, fmt.Errorf("Config and Token params are required")
 	}
@@ -416,7 +341,7 @@ func (d *debitCardService) GetVirtualDebitCardImage(ctx context.Context, params
 	}
 
 	// TODO: Does this require a different Accept header type (image/jpeg)?
-	res, err := d.client.doRequest(ctx, http.MethodGet, "assets/virtual_card_image", v, nil)
+	res, err := d.client.doRequest(ctx, newOperation("DebitCards.GetVirtualDebitCardImage", http.MethodGet, "assets/virtual_card_image"), params, v, nil, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,71 @@
+package rize
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// Do calls an API endpoint that the SDK does not wrap yet, with the same authentication, retries,
+// logging, error handling and middleware as the service methods. The path is relative to the API base
+// path (e.g. `customers/EhrQZJNjCd79LLYq`). The body, if not nil, is sent as JSON and the response body
+// is decoded into out, if not nil. A query string in the path is merged into query. API errors are
+// returned as *Error.
+func (rc *Client) Do(ctx context.Context, method string, path string, query url.Values, body interface{}, out interface{}, opts ...CallOption) (*Response, error) {
+	if method == "" || path == "" {
+		return nil, fmt.Errorf("method and path are required")
+	}
+
+	path, rawQuery, _ := strings.Cut(path, "?")
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "/"), internal.BasePath+"/")
+	if rawQuery != "" {
+		values, err := url.ParseQuery(rawQuery)
+		if err != nil {
+			return nil, fmt.Errorf("rize: invalid query string in path %s: %w", path, err)
+		}
+		query = mergeQuery(query, values)
+	}
+
+	// Only the endpoint group is kept in the path template, so that it can be used as a low
+	// cardinality route in traces and metrics
+	group, rest, found := strings.Cut(path, "/")
+	template := group
+	if found {
+		template += "/{path}"
+	}
+	op := newOperation("Client.Do", strings.ToUpper(method), template, rest)
+
+	var data io.Reader
+	if body != nil {
+		bytesMessage, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		data = bytes.NewBuffer(bytesMessage)
+	}
+
+	resp := &Response{}
+	opts = append(opts, WithResponse(resp))
+	if _, err := rc.doRequest(ctx, op, body, query, data, out, opts...); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Returns a copy of query with the values of extra added, leaving both unchanged
+func mergeQuery(query url.Values, extra url.Values) url.Values {
+	merged := url.Values{}
+	for _, values := range []url.Values{query, extra} {
+		for k, v := range values {
+			merged[k] = append(merged[k], v...)
+		}
+	}
+	return merged
+}
@@ -2,9 +2,7 @@ package rize
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
@@ -20,9 +18,9 @@ type Document struct {
 	DocumentType         string    `json:"document_type,omitempty"`
 	ScopeType            string    `json:"scope_type,omitempty"`
 	Name                 string    `json:"name,omitempty"`
-	PeriodStartedAt      time.Time `json:"period_started_at,omitempty"`
-	PeriodEndedAt        time.Time `json:"period_ended_at,omitempty"`
-	CreatedAt            time.Time `json:"created_at,omitempty"`
+	PeriodStartedAt      time.Time `json:"period_started_at"`
+	PeriodEndedAt        time.Time `json:"period_ended_at"`
+	CreatedAt            time.Time `json:"created_at"`
 	CustomerUIDs         []string  `json:"customer_uids,omitempty"`
 	CustodialAccountUIDs []string  `json:"custodial_account_uids,omitempty"`
 	SyntheticAccountUIDs []string  `json:"synthetic_account_uids,omitempty"`
@@ -47,51 +45,54 @@ type DocumentListResponse struct {
 }
 
 // List retrieves a list of Documents filtered by the given parameters
-func (d *documentService) List(ctx context.Context, params *DocumentListParams) (*DocumentListResponse, error) {
+func (d *documentService) List(ctx context.Context, params *DocumentListParams, opts ...CallOption) (*DocumentListResponse, error) {
 	// Build DocumentListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodGet, "documents", v, nil)
-	if err != nil {
+	response := &DocumentListResponse{}
+	if _, err := d.client.doRequest(ctx, newOperation("Documents.List", http.MethodGet, "documents"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &DocumentListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Documents matching the given parameters, fetching additional pages as needed
+func (d *documentService) Iterate(ctx context.Context, params *DocumentListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*Document] {
+	if params == nil {
+		params = &DocumentListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*Document, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := d.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Documents matching the given parameters across all pages
+func (d *documentService) ListAll(ctx context.Context, params *DocumentListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*Document, error) {
+	return d.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Get returns a single Document
-func (d *documentService) Get(ctx context.Context, uid string) (*Document, error) {
+func (d *documentService) Get(ctx context.Context, uid string, opts ...CallOption) (*Document, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := d.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("documents/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Document{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := d.client.doRequest(ctx, newOperation("Documents.Get", http.MethodGet, "documents/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -99,13 +100,13 @@ func (d *documentService) Get(ctx context.Context, uid string) (*Document, error
 }
 
 // View is used to retrieve a Document and return it in either PDF or HTML format
-func (d *documentService) View(ctx context.Context, uid string) (*http.Response, error) {
+func (d *documentService) View(ctx context.Context, uid string, opts ...CallOption) (*http.Response, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
 	// TODO: Does this require a different Accept header type (application/pdf)?
-	res, err := d.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("documents/%s/view", uid), nil, nil)
+	res, err := d.client.doRequest(ctx, newOperation("Documents.View", http.MethodGet, "documents/{uid}/view", uid), nil, nil, nil, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
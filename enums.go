@@ -0,0 +1,534 @@
+package rize
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+// The enum types below are strings, so values the API adds in the future are decoded and
+// preserved as-is. Use IsValid to check whether a value is one known to this SDK.
+
+// CustomerStatus is the onboarding status of a Customer
+type CustomerStatus string
+
+// Customer statuses
+const (
+	CustomerStatusInitiated        CustomerStatus = "initiated"
+	CustomerStatusQueued           CustomerStatus = "queued"
+	CustomerStatusIdentityVerified CustomerStatus = "identity_verified"
+	CustomerStatusActive           CustomerStatus = "active"
+	CustomerStatusManualReview     CustomerStatus = "manual_review"
+	CustomerStatusUnderReview      CustomerStatus = "under_review"
+	CustomerStatusRejected         CustomerStatus = "rejected"
+	CustomerStatusArchived         CustomerStatus = "archived"
+)
+
+var customerStatuses = []CustomerStatus{
+	CustomerStatusInitiated,
+	CustomerStatusQueued,
+	CustomerStatusIdentityVerified,
+	CustomerStatusActive,
+	CustomerStatusManualReview,
+	CustomerStatusUnderReview,
+	CustomerStatusRejected,
+	CustomerStatusArchived,
+}
+
+// String returns the API value
+func (s CustomerStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s CustomerStatus) IsValid() bool { return slices.Contains(customerStatuses, s) }
+
+// KYCStatus is the KYC status of a Customer
+type KYCStatus string
+
+// KYC statuses
+const (
+	KYCStatusApproved                       KYCStatus = "approved"
+	KYCStatusDenied                         KYCStatus = "denied"
+	KYCStatusDocumentsProvided              KYCStatus = "documents_provided"
+	KYCStatusDocumentsRejected              KYCStatus = "documents_rejected"
+	KYCStatusManualReview                   KYCStatus = "manual_review"
+	KYCStatusPendingDocuments               KYCStatus = "pending_documents"
+	KYCStatusReadyForCustodialPartnerReview KYCStatus = "ready_for_custodial_partner_review"
+	KYCStatusUnderReview                    KYCStatus = "under_review"
+)
+
+var kycStatuses = []KYCStatus{
+	KYCStatusApproved,
+	KYCStatusDenied,
+	KYCStatusDocumentsProvided,
+	KYCStatusDocumentsRejected,
+	KYCStatusManualReview,
+	KYCStatusPendingDocuments,
+	KYCStatusReadyForCustodialPartnerReview,
+	KYCStatusUnderReview,
+}
+
+// String returns the API value
+func (s KYCStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s KYCStatus) IsValid() bool { return slices.Contains(kycStatuses, s) }
+
+// CustomerType is the type of a Customer
+type CustomerType string
+
+// Customer types
+const (
+	CustomerTypePrimary   CustomerType = "primary"
+	CustomerTypeSecondary CustomerType = "secondary"
+)
+
+var customerTypes = []CustomerType{
+	CustomerTypePrimary,
+	CustomerTypeSecondary,
+}
+
+// String returns the API value
+func (t CustomerType) String() string { return string(t) }
+
+// IsValid reports whether the type is one known to the SDK
+func (t CustomerType) IsValid() bool { return slices.Contains(customerTypes, t) }
+
+// DebitCardStatus is the status of a Debit Card
+type DebitCardStatus string
+
+// Debit Card statuses
+const (
+	DebitCardStatusQueued DebitCardStatus = "queued"
+	DebitCardStatusIssued DebitCardStatus = "issued"
+	DebitCardStatusNormal DebitCardStatus = "normal"
+	DebitCardStatusLocked DebitCardStatus = "locked"
+	DebitCardStatusLost   DebitCardStatus = "lost"
+	DebitCardStatusStolen DebitCardStatus = "stolen"
+	DebitCardStatusClosed DebitCardStatus = "closed"
+)
+
+var debitCardStatuses = []DebitCardStatus{
+	DebitCardStatusQueued,
+	DebitCardStatusIssued,
+	DebitCardStatusNormal,
+	DebitCardStatusLocked,
+	DebitCardStatusLost,
+	DebitCardStatusStolen,
+	DebitCardStatusClosed,
+}
+
+// String returns the API value
+func (s DebitCardStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s DebitCardStatus) IsValid() bool { return slices.Contains(debitCardStatuses, s) }
+
+// DebitCardType is the type of a Debit Card
+type DebitCardType string
+
+// Debit Card types
+const (
+	DebitCardTypePhysical DebitCardType = "physical"
+	DebitCardTypeVirtual  DebitCardType = "virtual"
+)
+
+var debitCardTypes = []DebitCardType{
+	DebitCardTypePhysical,
+	DebitCardTypeVirtual,
+}
+
+// String returns the API value
+func (t DebitCardType) String() string { return string(t) }
+
+// IsValid reports whether the type is one known to the SDK
+func (t DebitCardType) IsValid() bool { return slices.Contains(debitCardTypes, t) }
+
+// DebitCardLockReason is the reason a Debit Card is locked
+type DebitCardLockReason string
+
+// Debit Card lock reasons
+const (
+	DebitCardLockReasonFraud  DebitCardLockReason = "fraud"
+	DebitCardLockReasonLost   DebitCardLockReason = "lost"
+	DebitCardLockReasonStolen DebitCardLockReason = "stolen"
+	DebitCardLockReasonOther  DebitCardLockReason = "other"
+)
+
+var debitCardLockReasons = []DebitCardLockReason{
+	DebitCardLockReasonFraud,
+	DebitCardLockReasonLost,
+	DebitCardLockReasonStolen,
+	DebitCardLockReasonOther,
+}
+
+// String returns the API value
+func (r DebitCardLockReason) String() string { return string(r) }
+
+// IsValid reports whether the reason is one known to the SDK
+func (r DebitCardLockReason) IsValid() bool { return slices.Contains(debitCardLockReasons, r) }
+
+// DebitCardReissueReason is the reason a Debit Card is reissued
+type DebitCardReissueReason string
+
+// Debit Card reissue reasons
+const (
+	DebitCardReissueReasonDamaged DebitCardReissueReason = "damaged"
+	DebitCardReissueReasonLost    DebitCardReissueReason = "lost"
+	DebitCardReissueReasonStolen  DebitCardReissueReason = "stolen"
+)
+
+var debitCardReissueReasons = []DebitCardReissueReason{
+	DebitCardReissueReasonDamaged,
+	DebitCardReissueReasonLost,
+	DebitCardReissueReasonStolen,
+}
+
+// String returns the API value
+func (r DebitCardReissueReason) String() string { return string(r) }
+
+// IsValid reports whether the reason is one known to the SDK
+func (r DebitCardReissueReason) IsValid() bool { return slices.Contains(debitCardReissueReasons, r) }
+
+// TransactionType is the type of a Transaction
+type TransactionType string
+
+// Transaction types
+const (
+	TransactionTypeATMWithdrawal      TransactionType = "atm_withdrawal"
+	TransactionTypeCardPurchase       TransactionType = "card_purchase"
+	TransactionTypeCardRefund         TransactionType = "card_refund"
+	TransactionTypeCredit             TransactionType = "credit"
+	TransactionTypeDispute            TransactionType = "dispute"
+	TransactionTypeExternalTransfer   TransactionType = "external_transfer"
+	TransactionTypeFee                TransactionType = "fee"
+	TransactionTypeInternalTransfer   TransactionType = "internal_transfer"
+	TransactionTypeOther              TransactionType = "other"
+	TransactionTypeReversedTransfer   TransactionType = "reversed_transfer"
+	TransactionTypeThirdPartyTransfer TransactionType = "third_party_transfer"
+)
+
+var transactionTypes = []TransactionType{
+	TransactionTypeATMWithdrawal,
+	TransactionTypeCardPurchase,
+	TransactionTypeCardRefund,
+	TransactionTypeCredit,
+	TransactionTypeDispute,
+	TransactionTypeExternalTransfer,
+	TransactionTypeFee,
+	TransactionTypeInternalTransfer,
+	TransactionTypeOther,
+	TransactionTypeReversedTransfer,
+	TransactionTypeThirdPartyTransfer,
+}
+
+// String returns the API value
+func (t TransactionType) String() string { return string(t) }
+
+// IsValid reports whether the type is one known to the SDK
+func (t TransactionType) IsValid() bool { return slices.Contains(transactionTypes, t) }
+
+// TransactionStatus is the status of a Transaction
+type TransactionStatus string
+
+// Transaction statuses
+const (
+	TransactionStatusQueued  TransactionStatus = "queued"
+	TransactionStatusPending TransactionStatus = "pending"
+	TransactionStatusSettled TransactionStatus = "settled"
+	TransactionStatusFailed  TransactionStatus = "failed"
+	TransactionStatusVoided  TransactionStatus = "voided"
+)
+
+var transactionStatuses = []TransactionStatus{
+	TransactionStatusQueued,
+	TransactionStatusPending,
+	TransactionStatusSettled,
+	TransactionStatusFailed,
+	TransactionStatusVoided,
+}
+
+// String returns the API value
+func (s TransactionStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s TransactionStatus) IsValid() bool { return slices.Contains(transactionStatuses, s) }
+
+// TransferStatus is the status of a Transfer
+type TransferStatus string
+
+// Transfer statuses
+const (
+	TransferStatusPending    TransferStatus = "pending"
+	TransferStatusQueued     TransferStatus = "queued"
+	TransferStatusProcessing TransferStatus = "processing"
+	TransferStatusSettled    TransferStatus = "settled"
+	TransferStatusFailed     TransferStatus = "failed"
+)
+
+var transferStatuses = []TransferStatus{
+	TransferStatusPending,
+	TransferStatusQueued,
+	TransferStatusProcessing,
+	TransferStatusSettled,
+	TransferStatusFailed,
+}
+
+// String returns the API value
+func (s TransferStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s TransferStatus) IsValid() bool { return slices.Contains(transferStatuses, s) }
+
+// SandboxTransactionType is the type of a mock Transaction created in the Sandbox
+type SandboxTransactionType string
+
+// Sandbox transaction types
+const (
+	SandboxTransactionTypeATMWithdrawal      SandboxTransactionType = "atm_withdrawal"
+	SandboxTransactionTypeCardPurchase       SandboxTransactionType = "card_purchase"
+	SandboxTransactionTypeCardRefund         SandboxTransactionType = "card_refund"
+	SandboxTransactionTypeDispute            SandboxTransactionType = "dispute"
+	SandboxTransactionTypeThirdPartyTransfer SandboxTransactionType = "third_party_transfer"
+)
+
+var sandboxTransactionTypes = []SandboxTransactionType{
+	SandboxTransactionTypeATMWithdrawal,
+	SandboxTransactionTypeCardPurchase,
+	SandboxTransactionTypeCardRefund,
+	SandboxTransactionTypeDispute,
+	SandboxTransactionTypeThirdPartyTransfer,
+}
+
+// String returns the API value
+func (t SandboxTransactionType) String() string { return string(t) }
+
+// IsValid reports whether the type is one known to the SDK
+func (t SandboxTransactionType) IsValid() bool { return slices.Contains(sandboxTransactionTypes, t) }
+
+// WorkflowStatus is the status of a Compliance Workflow
+type WorkflowStatus string
+
+// Compliance Workflow statuses
+const (
+	WorkflowStatusInProgress WorkflowStatus = "in_progress"
+	WorkflowStatusAccepted   WorkflowStatus = "accepted"
+	WorkflowStatusRejected   WorkflowStatus = "rejected"
+	WorkflowStatusExpired    WorkflowStatus = "expired"
+)
+
+var workflowStatuses = []WorkflowStatus{
+	WorkflowStatusInProgress,
+	WorkflowStatusAccepted,
+	WorkflowStatusRejected,
+	WorkflowStatusExpired,
+}
+
+// String returns the API value
+func (s WorkflowStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s WorkflowStatus) IsValid() bool { return slices.Contains(workflowStatuses, s) }
+
+// AdjustmentStatus is the status of an Adjustment
+type AdjustmentStatus string
+
+// Adjustment statuses
+const (
+	AdjustmentStatusInitiated AdjustmentStatus = "initiated"
+	AdjustmentStatusPending   AdjustmentStatus = "pending"
+	AdjustmentStatusSettled   AdjustmentStatus = "settled"
+	AdjustmentStatusFailed    AdjustmentStatus = "failed"
+)
+
+var adjustmentStatuses = []AdjustmentStatus{
+	AdjustmentStatusInitiated,
+	AdjustmentStatusPending,
+	AdjustmentStatusSettled,
+	AdjustmentStatusFailed,
+}
+
+// String returns the API value
+func (s AdjustmentStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s AdjustmentStatus) IsValid() bool { return slices.Contains(adjustmentStatuses, s) }
+
+// SyntheticAccountStatus is the status of a Synthetic Account
+type SyntheticAccountStatus string
+
+// Synthetic Account statuses
+const (
+	SyntheticAccountStatusInitiated SyntheticAccountStatus = "initiated"
+	SyntheticAccountStatusActive    SyntheticAccountStatus = "active"
+	SyntheticAccountStatusClosed    SyntheticAccountStatus = "closed"
+	SyntheticAccountStatusArchived  SyntheticAccountStatus = "archived"
+)
+
+var syntheticAccountStatuses = []SyntheticAccountStatus{
+	SyntheticAccountStatusInitiated,
+	SyntheticAccountStatusActive,
+	SyntheticAccountStatusClosed,
+	SyntheticAccountStatusArchived,
+}
+
+// String returns the API value
+func (s SyntheticAccountStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s SyntheticAccountStatus) IsValid() bool { return slices.Contains(syntheticAccountStatuses, s) }
+
+// CustodialAccountStatus is the status of a Custodial Account
+type CustodialAccountStatus string
+
+// Custodial Account statuses
+const (
+	CustodialAccountStatusInitiated CustodialAccountStatus = "initiated"
+	CustodialAccountStatusActive    CustodialAccountStatus = "active"
+	CustodialAccountStatusClosed    CustodialAccountStatus = "closed"
+	CustodialAccountStatusArchived  CustodialAccountStatus = "archived"
+)
+
+var custodialAccountStatuses = []CustodialAccountStatus{
+	CustodialAccountStatusInitiated,
+	CustodialAccountStatusActive,
+	CustodialAccountStatusClosed,
+	CustodialAccountStatusArchived,
+}
+
+// String returns the API value
+func (s CustodialAccountStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s CustodialAccountStatus) IsValid() bool { return slices.Contains(custodialAccountStatuses, s) }
+
+// CustodialAccountType is the type of a Custodial Account
+type CustodialAccountType string
+
+// Custodial Account types
+const (
+	CustodialAccountTypeDDA          CustodialAccountType = "dda"
+	CustodialAccountTypeForBenefitOf CustodialAccountType = "for_benefit_of"
+	CustodialAccountTypeBrokerage    CustodialAccountType = "brokerage"
+)
+
+var custodialAccountTypes = []CustodialAccountType{
+	CustodialAccountTypeDDA,
+	CustodialAccountTypeForBenefitOf,
+	CustodialAccountTypeBrokerage,
+}
+
+// String returns the API value
+func (t CustodialAccountType) String() string { return string(t) }
+
+// IsValid reports whether the type is one known to the SDK
+func (t CustodialAccountType) IsValid() bool { return slices.Contains(custodialAccountTypes, t) }
+
+// CustomerProductStatus is the status of a Customer's enrollment in a Product
+type CustomerProductStatus string
+
+// Customer Product statuses
+const (
+	CustomerProductStatusInitiated   CustomerProductStatus = "initiated"
+	CustomerProductStatusPending     CustomerProductStatus = "pending"
+	CustomerProductStatusUnderReview CustomerProductStatus = "under_review"
+	CustomerProductStatusActive      CustomerProductStatus = "active"
+	CustomerProductStatusRejected    CustomerProductStatus = "rejected"
+)
+
+var customerProductStatuses = []CustomerProductStatus{
+	CustomerProductStatusInitiated,
+	CustomerProductStatusPending,
+	CustomerProductStatusUnderReview,
+	CustomerProductStatusActive,
+	CustomerProductStatusRejected,
+}
+
+// String returns the API value
+func (s CustomerProductStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s CustomerProductStatus) IsValid() bool { return slices.Contains(customerProductStatuses, s) }
+
+// PinwheelJobStatus is the status of a Pinwheel Job
+type PinwheelJobStatus string
+
+// Pinwheel Job statuses
+const (
+	PinwheelJobStatusInitiated PinwheelJobStatus = "initiated"
+	PinwheelJobStatusPending   PinwheelJobStatus = "pending"
+	PinwheelJobStatusCompleted PinwheelJobStatus = "completed"
+	PinwheelJobStatusFailed    PinwheelJobStatus = "failed"
+	PinwheelJobStatusExpired   PinwheelJobStatus = "expired"
+)
+
+var pinwheelJobStatuses = []PinwheelJobStatus{
+	PinwheelJobStatusInitiated,
+	PinwheelJobStatusPending,
+	PinwheelJobStatusCompleted,
+	PinwheelJobStatusFailed,
+	PinwheelJobStatusExpired,
+}
+
+// String returns the API value
+func (s PinwheelJobStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s PinwheelJobStatus) IsValid() bool { return slices.Contains(pinwheelJobStatuses, s) }
+
+// TransactionEventType is the type of a Transaction Event
+type TransactionEventType string
+
+// Transaction Event types
+const (
+	TransactionEventTypeATMWithdrawal     TransactionEventType = "atm_withdrawal"
+	TransactionEventTypeCardPurchase      TransactionEventType = "card_purchase"
+	TransactionEventTypeCardRefund        TransactionEventType = "card_refund"
+	TransactionEventTypeDispute           TransactionEventType = "dispute"
+	TransactionEventTypeInternalTransfer  TransactionEventType = "internal_transfer"
+	TransactionEventTypeODFIACHDeposit    TransactionEventType = "odfi_ach_deposit"
+	TransactionEventTypeODFIACHWithdrawal TransactionEventType = "odfi_ach_withdrawal"
+	TransactionEventTypeRDFIACHDeposit    TransactionEventType = "rdfi_ach_deposit"
+	TransactionEventTypeRDFIACHWithdrawal TransactionEventType = "rdfi_ach_withdrawal"
+)
+
+var transactionEventTypes = []TransactionEventType{
+	TransactionEventTypeATMWithdrawal,
+	TransactionEventTypeCardPurchase,
+	TransactionEventTypeCardRefund,
+	TransactionEventTypeDispute,
+	TransactionEventTypeInternalTransfer,
+	TransactionEventTypeODFIACHDeposit,
+	TransactionEventTypeODFIACHWithdrawal,
+	TransactionEventTypeRDFIACHDeposit,
+	TransactionEventTypeRDFIACHWithdrawal,
+}
+
+// String returns the API value
+func (t TransactionEventType) String() string { return string(t) }
+
+// IsValid reports whether the type is one known to the SDK
+func (t TransactionEventType) IsValid() bool { return slices.Contains(transactionEventTypes, t) }
+
+// LineItemStatus is the status of a Synthetic or Custodial Line Item
+type LineItemStatus string
+
+// Line Item statuses
+const (
+	LineItemStatusPending    LineItemStatus = "pending"
+	LineItemStatusInProgress LineItemStatus = "in_progress"
+	LineItemStatusSettled    LineItemStatus = "settled"
+	LineItemStatusFailed     LineItemStatus = "failed"
+	LineItemStatusVoided     LineItemStatus = "voided"
+)
+
+var lineItemStatuses = []LineItemStatus{
+	LineItemStatusPending,
+	LineItemStatusInProgress,
+	LineItemStatusSettled,
+	LineItemStatusFailed,
+	LineItemStatusVoided,
+}
+
+// String returns the API value
+func (s LineItemStatus) String() string { return string(s) }
+
+// IsValid reports whether the status is one known to the SDK
+func (s LineItemStatus) IsValid() bool { return slices.Contains(lineItemStatuses, s) }
@@ -0,0 +1,135 @@
+package rize
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// Sentinel errors used to classify API errors. Use errors.Is to check an error returned by the SDK:
+//
+//	if errors.Is(err, rize.ErrNotFound) { ... }
+var (
+	// ErrNotFound is returned when the requested resource does not exist (404)
+	ErrNotFound = errors.New("rize: not found")
+	// ErrUnauthorized is returned when the request is not authenticated or not permitted (401, 403)
+	ErrUnauthorized = errors.New("rize: unauthorized")
+	// ErrRateLimited is returned when too many requests were sent (429)
+	ErrRateLimited = errors.New("rize: rate limited")
+	// ErrValidation is returned when the request parameters were rejected (400, 422)
+	ErrValidation = errors.New("rize: validation failed")
+	// ErrConflict is returned when the request conflicts with the current state of the resource (409)
+	ErrConflict = errors.New("rize: conflict")
+	// ErrServer is returned when the API failed to process the request (5xx)
+	ErrServer = errors.New("rize: server error")
+	// ErrCircuitOpen is returned when a request is rejected by an open circuit breaker, without
+	// being sent to the API. See CircuitOpenError
+	ErrCircuitOpen = errors.New("rize: circuit breaker open")
+	// ErrRateLimitWait is returned when the client-side rate limit would delay a request past its
+	// context deadline. The request is not sent
+	ErrRateLimitWait = errors.New("rize: rate limit wait exceeds context deadline")
+)
+
+// Error is the default API error format
+type Error struct {
+	Errors []*ErrorDetails `json:"errors"`
+	Status int             `json:"status"`
+	// HTTP method of the failed request
+	Method string `json:"-"`
+	// URL path of the failed request
+	Path string `json:"-"`
+	// Request ID assigned by the API, if any. Include this when contacting Rize support
+	RequestID string `json:"-"`
+	// Raw response body
+	Body []byte `json:"-"`
+}
+
+// ErrorDetails from the error response
+type ErrorDetails struct {
+	Code       int       `json:"code,omitempty"`
+	Title      string    `json:"title,omitempty"`
+	Detail     string    `json:"detail,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Build an Error from a non-2xx response. Bodies that are empty or not in the API error
+// format (such as an HTML page from a gateway) are kept in Body.
+func newError(res *http.Response, body []byte) *Error {
+	e := &Error{}
+	if err := json.Unmarshal(body, e); err != nil {
+		e = &Error{}
+	}
+
+	e.Status = res.StatusCode
+	e.Body = body
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.Path = res.Request.URL.Path
+	}
+	e.RequestID = requestID(res.Header)
+
+	return e
+}
+
+// Format error output
+func (e *Error) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprint("Rize API Error ", e.Status))
+	if e.Method != "" {
+		sb.WriteString(fmt.Sprintf(" %s %s", e.Method, e.Path))
+	}
+	if e.RequestID != "" {
+		sb.WriteString(fmt.Sprintf(" (request ID %s)", e.RequestID))
+	}
+	sb.WriteString("\n")
+	for _, v := range e.Errors {
+		sb.WriteString(fmt.Sprintf("%+v", v))
+	}
+
+	// Include the start of the raw body when it could not be parsed
+	if len(e.Errors) == 0 && len(e.Body) > 0 {
+		body := string(e.Body)
+		if len(body) > internal.ErrorBodyMaxLength {
+			body = body[:internal.ErrorBodyMaxLength] + "..."
+		}
+		sb.WriteString(body)
+	}
+	return sb.String()
+}
+
+// Is reports whether the error matches one of the sentinel errors, based on the HTTP status
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.Status == http.StatusUnauthorized || e.Status == http.StatusForbidden
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.Status == http.StatusBadRequest || e.Status == http.StatusUnprocessableEntity
+	case ErrConflict:
+		return e.Status == http.StatusConflict
+	case ErrServer:
+		return e.Status >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// Retryable reports whether the request failed with a transient error and may succeed if
+// sent again (429, 502, 503 and 504)
+func (e *Error) Retryable() bool {
+	switch e.Status {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
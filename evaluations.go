@@ -2,9 +2,7 @@ package rize
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
@@ -18,7 +16,7 @@ type evaluationService service
 type Evaluation struct {
 	UID       string              `json:"uid,omitempty"`
 	Outcome   string              `json:"outcome,omitempty"`
-	CreatedAt time.Time           `json:"created_at,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
 	Flags     *EvaluationFlag     `json:"flags,omitempty"`
 	PIIMatch  *EvaluationPIIMatch `json:"pii_match,omitempty"`
 }
@@ -54,26 +52,15 @@ type EvaluationListResponse struct {
 }
 
 // List retrieves a list of Evaluations filtered by the given parameters
-func (p *evaluationService) List(ctx context.Context, params *EvaluationListParams) (*EvaluationListResponse, error) {
+func (p *evaluationService) List(ctx context.Context, params *EvaluationListParams, opts ...CallOption) (*EvaluationListResponse, error) {
 	// Build EvaluationListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := p.client.doRequest(ctx, http.MethodGet, "evaluations", v, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &EvaluationListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := p.client.doRequest(ctx, newOperation("Evaluations.List", http.MethodGet, "evaluations"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -81,24 +68,13 @@ func (p *evaluationService) List(ctx context.Context, params *EvaluationListPara
 }
 
 // Get returns a single Evaluation
-func (p *evaluationService) Get(ctx context.Context, uid string) (*Evaluation, error) {
+func (p *evaluationService) Get(ctx context.Context, uid string, opts ...CallOption) (*Evaluation, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := p.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("evaluations/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Evaluation{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := p.client.doRequest(ctx, newOperation("Evaluations.Get", http.MethodGet, "evaluations/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -14,8 +14,8 @@ func (e Example) ExampleAdjustmentService_List(rc *rize.Client) {
 		CustomerUID:            "uKxmLxUEiSj5h4M3",
 		AdjustmentTypeUID:      "2Ej2tsFbQT3S1HYd",
 		ExternalUID:            "PT3sH7oxxQPwchrf",
-		USDAdjustmentAmountMax: 10,
-		USDAdjustmentAmountMin: 5,
+		USDAdjustmentAmountMax: rize.Some(rize.NewMoney(10, 0)),
+		USDAdjustmentAmountMin: rize.Some(rize.NewMoney(5, 0)),
 		Sort:                   "adjustment_type_name_asc",
 	}
 	resp, err := rc.Adjustments.List(context.Background(), params)
@@ -31,7 +31,7 @@ func (e Example) ExampleAdjustmentService_Create(rc *rize.Client) {
 	params := &rize.AdjustmentCreateParams{
 		ExternalUID:         "partner-generated-id",
 		CustomerUID:         "kaxHFJnWvJxRJZxq",
-		USDAdjustmentAmount: "2.43",
+		USDAdjustmentAmount: rize.MustParseMoney("2.43"),
 		AdjustmentTypeUID:   "KM2eKbR98t4tdAyZ",
 	}
 	resp, err := rc.Adjustments.Create(context.Background(), params)
@@ -16,35 +16,20 @@ func (e Example) ExampleClientService_WithPagination(rc *rize.Client) {
 		Sort:   "first_name_asc",
 	}
 
-	var (
-		count int
-		list  *rize.CustomerListResponse
-		data  []*rize.Customer
-	)
-
-	for {
-		resp, err := rc.Customers.List(context.Background(), params)
-		if err != nil {
-			log.Fatal("Error fetching customers\n", err)
-		}
-
-		data = append(data, resp.Data...)
-		count += resp.Count
-
-		if count >= resp.TotalCount {
-			list = &rize.CustomerListResponse{
-				ListResponse: rize.ListResponse{
-					TotalCount: resp.TotalCount,
-					Count:      count,
-					Limit:      resp.Limit,
-					Offset:     params.Offset,
-				},
-				Data: data,
-			}
-			break
-		}
+	// Fetch every page of Customers, 100 at a time
+	data, err := rc.Customers.ListAll(context.Background(), params, nil)
+	if err != nil {
+		log.Fatal("Error fetching customers\n", err)
+	}
 
-		params.Offset += count
+	list := &rize.CustomerListResponse{
+		ListResponse: rize.ListResponse{
+			TotalCount: len(data),
+			Count:      len(data),
+			Limit:      params.Limit,
+			Offset:     params.Offset,
+		},
+		Data: data,
 	}
 
 	output, _ := json.MarshalIndent(list, "", "\t")
@@ -13,7 +13,7 @@ func (e Example) ExampleComplianceWorkflowService_List(rc *rize.Client) {
 	params := &rize.WorkflowListParams{
 		CustomerUID: "S62MaHx6WwsqG9vQ",
 		ProductUID:  "pQtTCSXz57fuefzp",
-		InProgress:  true,
+		InProgress:  rize.Some(true),
 		Limit:       100,
 		Offset:      10,
 	}
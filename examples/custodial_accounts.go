@@ -15,7 +15,7 @@ func (e Example) ExampleCustodialAccountService_List(rc *rize.Client) {
 		ExternalUID: "client-generated-id",
 		Limit:       100,
 		Offset:      10,
-		Liability:   true,
+		Liability:   rize.Some(true),
 		Type:        "dda",
 	}
 	resp, err := rc.CustodialAccounts.List(context.Background(), params)
@@ -7,21 +7,20 @@ import (
 	"time"
 
 	"github.com/rizefinance/rize-go-sdk"
-	"github.com/rizefinance/rize-go-sdk/internal"
 )
 
 // List customers
 func (e Example) ExampleCustomerService_List(rc *rize.Client) {
 	params := &rize.CustomerListParams{
 		UID:              "uKxmLxUEiSj5h4M3",
-		Status:           "identity_verified",
-		IncludeInitiated: true,
-		KYCStatus:        "denied",
-		CustomerType:     "primary",
+		Status:           rize.CustomerStatusIdentityVerified,
+		IncludeInitiated: rize.Some(true),
+		KYCStatus:        rize.KYCStatusDenied,
+		CustomerType:     rize.CustomerTypePrimary,
 		FirstName:        "Olive",
 		LastName:         "Oyl",
 		Email:            "olive.oyl@popeyes.com",
-		Locked:           false,
+		Locked:           rize.Some(false),
 		ProgramUID:       "pQtTCSXz57fuefzp",
 		BusinessName:     "Business inc",
 		ExternalUID:      "client-generated-id",
@@ -42,7 +41,7 @@ func (e Example) ExampleCustomerService_List(rc *rize.Client) {
 // Create new customer
 func (e Example) ExampleCustomerService_Create(rc *rize.Client) {
 	params := &rize.CustomerCreateParams{
-		CustomerType:       "primary",
+		CustomerType:       rize.CustomerTypePrimary,
 		PrimaryCustomerUID: "kbF5TGrmwGizQuzZ",
 		ExternalUID:        "client-generated-id",
 		Email:              "olive.oyl@popeyes.com",
@@ -54,7 +53,7 @@ func (e Example) ExampleCustomerService_Create(rc *rize.Client) {
 			Phone:        "5555551212",
 			BusinessName: "Oliver's Olive Emporium",
 			SSN:          "111-22-3333",
-			DOB:          internal.DOB(time.Now()),
+			DOB:          rize.Date(time.Now()),
 			Address: &rize.CustomerAddress{
 				Street1:    "123 Abc St.",
 				Street2:    "Apt 2",
@@ -88,8 +87,8 @@ func (e Example) ExampleCustomerService_Get(rc *rize.Client) {
 // Update customer
 func (e Example) ExampleCustomerService_Update(rc *rize.Client) {
 	params := &rize.CustomerUpdateParams{
-		Email:       "olive.oyl@rizemoney.com",
-		ExternalUID: "client-generated-id",
+		Email:       rize.Some("olive.oyl@rizemoney.com"),
+		ExternalUID: rize.Some("client-generated-id"),
 		Details: &rize.CustomerDetails{
 			FirstName:    "Olive",
 			MiddleName:   "Olivia",
@@ -97,7 +96,7 @@ func (e Example) ExampleCustomerService_Update(rc *rize.Client) {
 			Suffix:       "Jr.",
 			Phone:        "5555551212",
 			BusinessName: "Oliver's Olive Emporium",
-			DOB:          internal.DOB(time.Now()),
+			DOB:          rize.Date(time.Now()),
 			SSN:          "111-22-3333",
 			SSNLastFour:  "3333",
 			Address: &rize.CustomerAddress{
@@ -172,7 +171,7 @@ func (e Example) ExampleCustomerService_UpdateProfileResponses(rc *rize.Client)
 	// Update Profile Response with string response
 	params := &rize.CustomerProfileResponseParams{
 		ProfileRequirementUID: "ptRLF7nQvy8VoqM1",
-		ProfileResponse: &internal.CustomerProfileResponseItem{
+		ProfileResponse: &rize.ProfileResponse{
 			Response: "Response string",
 		},
 	}
@@ -186,7 +185,7 @@ func (e Example) ExampleCustomerService_UpdateProfileResponses(rc *rize.Client)
 	// Update Profile Response with ordered list response
 	paramList := &rize.CustomerProfileResponseParams{
 		ProfileRequirementUID: "ptRLF7nQvy8VoqM1",
-		ProfileResponse: &internal.CustomerProfileResponseItem{
+		ProfileResponse: &rize.ProfileResponse{
 			Num0: "string",
 			Num1: "string",
 			Num2: "string",
@@ -16,8 +16,8 @@ func (e Example) ExampleDebitCardService_List(rc *rize.Client) {
 		Limit:       100,
 		Offset:      10,
 		PoolUID:     "wTSMX1GubP21ev2h",
-		Locked:      true,
-		Status:      "queued",
+		Locked:      rize.Some(true),
+		Status:      rize.DebitCardStatusQueued,
 	}
 	resp, err := rc.DebitCards.List(context.Background(), params)
 	if err != nil {
@@ -78,7 +78,7 @@ func (e Example) ExampleDebitCardService_Activate(rc *rize.Client) {
 // Lock Debit Card
 func (e Example) ExampleDebitCardService_Lock(rc *rize.Client) {
 	params := &rize.DebitCardLockParams{
-		LockReason: "Fraud detected",
+		LockReason: rize.DebitCardLockReasonFraud,
 	}
 	resp, err := rc.DebitCards.Lock(context.Background(), "Lt6qjTNnYLjFfEWL", params)
 	if err != nil {
@@ -102,7 +102,7 @@ func (e Example) ExampleDebitCardService_Unlock(rc *rize.Client) {
 func (e Example) ExampleDebitCardService_Reissue(rc *rize.Client) {
 	params := &rize.DebitCardReissueParams{
 		CardArtworkUID: "EhrQZJNjCd79LLYq",
-		ReissueReason:  "damaged",
+		ReissueReason:  rize.DebitCardReissueReasonDamaged,
 		ShippingAddress: &rize.DebitCardShippingAddress{
 			Street1:    "123 Abc St",
 			Street2:    "Apt 2",
@@ -11,11 +11,11 @@ import (
 // Create Sandbox Transaction
 func (e Example) ExampleSandboxService_Create(rc *rize.Client) {
 	params := &rize.SandboxCreateParams{
-		TransactionType:  "atm_withdrawal",
+		TransactionType:  rize.SandboxTransactionTypeATMWithdrawal,
 		CustomerUID:      "uKxmLxUEiSj5h4M3",
 		DebitCardUID:     "h9MzupcjtA3LPW2e",
 		DenialReason:     "insufficient_funds",
-		USDollarAmount:   21.89,
+		USDollarAmount:   rize.MustParseMoney("21.89"),
 		Mcc:              "5200",
 		MerchantLocation: "NEW YORK, NY",
 		MerchantName:     "Widgets Incorporated",
@@ -18,7 +18,7 @@ func (e Example) ExampleSyntheticAccountService_List(rc *rize.Client) {
 		Offset:                   10,
 		SyntheticAccountTypeUID:  "q4mdMxMtjXfdbrjn",
 		SyntheticAccountCategory: "general",
-		Liability:                true,
+		Liability:                rize.Some(true),
 		Status:                   "active",
 		Sort:                     "name_asc",
 	}
@@ -63,8 +63,8 @@ func (e Example) ExampleSyntheticAccountService_Get(rc *rize.Client) {
 // Update Synthetic Account
 func (e Example) ExampleSyntheticAccountService_Update(rc *rize.Client) {
 	params := &rize.SyntheticAccountUpdateParams{
-		Name: "New Resource Name",
-		Note: "note",
+		Name: rize.Some("New Resource Name"),
+		Note: rize.Some("note"),
 	}
 	resp, err := rc.SyntheticAccounts.Update(context.Background(), "EhrQZJNjCd79LLYq", params)
 	if err != nil {
@@ -80,7 +80,7 @@ func (e Example) ExampleSyntheticAccountService_Delete(rc *rize.Client) {
 	if err != nil {
 		log.Fatal("Error deleting Synthetic Account\n", err)
 	}
-	log.Println("Delete Synthetic Account:", resp.Status)
+	log.Println("Delete Synthetic Account:", resp.Response.StatusCode, resp.Response.RequestID)
 }
 
 // List Synthetic Account Types
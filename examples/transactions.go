@@ -17,10 +17,10 @@ func (e Example) ExampleTransactionService_List(rc *rize.Client) {
 		SourceSyntheticAccountUID:      "4XkJnsfHsuqrxmeX",
 		DestinationSyntheticAccountUID: "exMDShw6yM3NHLYV",
 		SyntheticAccountUID:            "4XkJnsfHsuqrxmeX",
-		Type:                           "card_refund",
+		Type:                           rize.TransactionTypeCardRefund,
 		ShowDeniedAuths:                true,
 		ShowExpired:                    true,
-		Status:                         "failed",
+		Status:                         rize.TransactionStatusFailed,
 		SearchDescription:              "Transfer%2A",
 		IncludeZero:                    true,
 		Limit:                          100,
@@ -111,8 +111,8 @@ func (e Example) ExampleTransactionService_ListCustodialLineItems(rc *rize.Clien
 		CustomerUID:         "uKxmLxUEiSj5h4M3",
 		CustodialAccountUID: "wTSMX1GubP21ev2h",
 		Status:              "voided",
-		USDollarAmountMax:   2,
-		USDollarAmountMin:   2,
+		USDollarAmountMax:   rize.Some(rize.NewMoney(2, 0)),
+		USDollarAmountMin:   rize.Some(rize.NewMoney(2, 0)),
 		TransactionEventUID: "MB2yqBrm3c4bUbou",
 		TransactionUID:      "SMwKC1osz77DTEiu",
 		Limit:               100,
@@ -33,7 +33,7 @@ func (e Example) ExampleTransferService_Create(rc *rize.Client) {
 		SourceSyntheticAccountUID:      "4XkJnsfHsuqrxmeX",
 		DestinationSyntheticAccountUID: "exMDShw6yM3NHLYV",
 		InitiatingCustomerUID:          "iDtmSA52zRhgN4iy",
-		USDTransferAmount:              "12.34",
+		USDTransferAmount:              rize.MustParseMoney("12.34"),
 	}
 	resp, err := rc.Transfers.Create(context.Background(), params)
 	if err != nil {
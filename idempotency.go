@@ -0,0 +1,120 @@
+package rize
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// Generates a random (version 4) UUID to be used as an idempotency key
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("rize: unable to generate idempotency key: %s", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Returns the ExternalUID of a Create request: the one in the params, the key set with
+// WithIdempotencyKey, or a newly generated key
+func createExternalUID(externalUID string, opts []CallOption) string {
+	if externalUID != "" {
+		return externalUID
+	}
+	if key := newCallOptions(opts).header.Get(internal.IdempotencyKeyHeader); key != "" {
+		return key
+	}
+	return newIdempotencyKey()
+}
+
+// Creates a resource identified by its ExternalUID, recovering from failures that leave it unknown
+// whether the API created it.
+//
+// create sends the request once, without the Client's retries. After a failure that may have
+// created the resource (see isAmbiguous), list looks the resource up by its ExternalUID, and the
+// request is only sent again, following the RetryPolicy, if nothing was found. Requests that fail
+// with 429, 502, 503 or 504 are sent again as well. A 409 or 422 response after an ambiguous
+// attempt may be the API rejecting the duplicate of a created resource, so it is looked up too.
+//
+// The call options, including the deadline set with WithCallTimeout, apply to the whole call,
+// lookups included
+func createWithExternalUID[T any](ctx context.Context, rc *Client, opts []CallOption,
+	create func(ctx context.Context, opts ...CallOption) (*T, error),
+	list func(ctx context.Context, opts ...CallOption) ([]*T, error)) (*T, error) {
+	if timeout := newCallOptions(opts).timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	policy := rc.cfg.RetryPolicy
+	var sent bool
+	for attempt := 1; ; attempt++ {
+		created, err := create(ctx, append(opts, withoutRetries())...)
+		if err == nil {
+			return created, nil
+		}
+
+		ambiguous := isAmbiguous(ctx, err)
+		if ambiguous || (sent && isDuplicate(ctx, err)) {
+			sent = true
+			if existing, err := list(ctx, opts...); err == nil && len(existing) > 0 {
+				return existing[0], nil
+			}
+		}
+
+		// API errors are sent again if they are transient, other errors if they were ambiguous
+		retry := ambiguous
+		var rerr *Error
+		if errors.As(err, &rerr) {
+			retry = rerr.Retryable()
+		}
+		if !retry || attempt >= policy.MaxAttempts {
+			return nil, err
+		}
+
+		delay, _ := policy.delay(attempt, nil)
+		rc.cfg.Logger.Warn("Create request failed, retrying", "error", err, "attempt", attempt, "delay", delay)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Checks whether a failed request may have been rejected as the duplicate of an existing resource
+// (409, 422)
+func isDuplicate(ctx context.Context, err error) bool {
+	var rerr *Error
+	if ctx.Err() != nil || !errors.As(err, &rerr) {
+		return false
+	}
+	return rerr.Status == http.StatusConflict || rerr.Status == http.StatusUnprocessableEntity
+}
+
+// Checks whether a failed request may still have been processed by the API. This is the case for
+// transport errors (timeouts, dropped connections) and server errors. Lookups are skipped once the
+// caller's context is done.
+func isAmbiguous(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	// The request was never sent
+	if errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrRateLimitWait) {
+		return false
+	}
+
+	var rerr *Error
+	if errors.As(err, &rerr) {
+		return errors.Is(rerr, ErrServer)
+	}
+
+	return true
+}
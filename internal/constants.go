@@ -7,9 +7,39 @@ import (
 // Shared SDK Constants
 const (
 	// Platform SDK
-	BasePath    = "api/v1"
-	APITimeout  = time.Second * 30
-	TokenMaxAge = int64((time.Hour * 23) / time.Millisecond)
+	BasePath   = "api/v1"
+	APITimeout = time.Second * 30
+	// Fallback token lifetime, used when the token does not carry an `exp` claim
+	TokenMaxAge = time.Hour * 23
+	// Refresh the token this long before it expires
+	TokenRefreshMargin = time.Minute * 5
+	// The refresh margin never exceeds this fraction of the token lifetime, so short-lived tokens are
+	// still used for most of their lifetime
+	TokenRefreshMaxFraction = 0.25
+	// File token store locks older than this are considered abandoned. Well above APITimeout, so a
+	// slow token refresh keeps its lock
+	TokenStoreLockTimeout = time.Minute * 2
+	// Interval between attempts to acquire a file token store lock
+	TokenStorePollInterval = time.Millisecond * 50
+	// Retry policy defaults
+	RetryMaxAttempts = 3
+	RetryBaseBackoff = time.Millisecond * 250
+	RetryMaxBackoff  = time.Second * 10
+	RetryJitter      = 0.2
+	// Client-side rate limit adaptation. The rate never drops below the minimum fraction of the
+	// configured rate, and the recovery fraction is restored after every successful response
+	RateLimitMinFraction      = 0.1
+	RateLimitRecoveryFraction = 0.05
+	// Circuit breaker defaults
+	CircuitFailureThreshold = 5
+	CircuitCoolDown         = time.Second * 30
+	CircuitSuccessThreshold = 1
+	// Header used to mark a mutating request as safe to retry
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// Header used to send a caller-supplied request ID
+	RequestIDHeader = "X-Request-Id"
+	// Maximum length of a raw response body included in an error message
+	ErrorBodyMaxLength = 200
 	// Message Queue
 	MQSendTimeout    = time.Millisecond * 5000
 	MQReceiveTimeout = time.Millisecond * 5000
@@ -20,5 +50,15 @@ const (
 // Environments are Rize infrastructure tiers
 var Environments = []string{"sandbox", "integration", "production"}
 
+// Rate limit response headers
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	RateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+// RequestIDHeaders are the response headers that may contain the API request ID
+var RequestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid", "X-Amz-Apigw-Id"}
+
 // MQServices are the available Message Queue topic services
 var MQServices = []string{"adjustments", "customer", "debit_card", "synthetic_account", "transfer", "transaction"}
@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// Level is the severity of a log entry. Values match the levels of the `log/slog` package
+type Level int
+
+// Supported log levels
+const (
+	LevelDebug Level = -4
+	LevelInfo  Level = 0
+	LevelWarn  Level = 4
+	LevelError Level = 8
+)
+
+// String returns the name of the level
+func (l Level) String() string {
+	switch {
+	case l >= LevelError:
+		return "ERROR"
+	case l >= LevelWarn:
+		return "WARN"
+	case l >= LevelInfo:
+		return "INFO"
+	default:
+		return "DEBUG"
+	}
+}
+
+// Logger is a levelled, structured logger. Each message is followed by alternating keys and values
+// (`"status", 500, "delay", time.Second`). The method set matches `*slog.Logger`
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// NopLogger discards all log entries
+type NopLogger struct{}
+
+// Debug discards the log entry
+func (NopLogger) Debug(msg string, keysAndValues ...interface{}) {}
+
+// Info discards the log entry
+func (NopLogger) Info(msg string, keysAndValues ...interface{}) {}
+
+// Warn discards the log entry
+func (NopLogger) Warn(msg string, keysAndValues ...interface{}) {}
+
+// Error discards the log entry
+func (NopLogger) Error(msg string, keysAndValues ...interface{}) {}
+
+// DefaultLogger returns the configured logger, wrapped to redact secrets and PII. Without one, debug
+// logging writes to stderr and is otherwise disabled. The standard library's global logger is never modified
+func DefaultLogger(logger Logger, debug bool) Logger {
+	if logger != nil {
+		return NewRedactingLogger(logger)
+	}
+	if debug {
+		return NewRedactingLogger(NewStdLogger(log.New(os.Stderr, "[rize] ", log.LstdFlags), LevelDebug))
+	}
+	return NopLogger{}
+}
+
+// Loggers that report the file and line of the caller, such as StdLogger. Wrappers pass the number of
+// frames they add, so the caller of the wrapper is reported instead
+type callerLogger interface {
+	logDepth(depth int, level Level, msg string, keysAndValues []interface{})
+}
+
+// StdLogger writes log entries at or above a minimum level to a standard library `*log.Logger`
+type StdLogger struct {
+	logger *log.Logger
+	level  Level
+}
+
+// NewStdLogger creates a StdLogger writing to the given `*log.Logger`
+func NewStdLogger(logger *log.Logger, level Level) *StdLogger {
+	return &StdLogger{logger: logger, level: level}
+}
+
+// Debug logs a message at LevelDebug
+func (s *StdLogger) Debug(msg string, keysAndValues ...interface{}) {
+	s.logDepth(1, LevelDebug, msg, keysAndValues)
+}
+
+// Info logs a message at LevelInfo
+func (s *StdLogger) Info(msg string, keysAndValues ...interface{}) {
+	s.logDepth(1, LevelInfo, msg, keysAndValues)
+}
+
+// Warn logs a message at LevelWarn
+func (s *StdLogger) Warn(msg string, keysAndValues ...interface{}) {
+	s.logDepth(1, LevelWarn, msg, keysAndValues)
+}
+
+// Error logs a message at LevelError
+func (s *StdLogger) Error(msg string, keysAndValues ...interface{}) {
+	s.logDepth(1, LevelError, msg, keysAndValues)
+}
+
+// Formats the entry as `[LEVEL] msg key=value ...`. depth is the number of frames between the caller
+// being logged and this method, so the `log.Lshortfile` flags report the caller
+func (s *StdLogger) logDepth(depth int, level Level, msg string, keysAndValues []interface{}) {
+	if level < s.level {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] %s", level, msg)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 < len(keysAndValues) {
+			fmt.Fprintf(&b, " %v=%v", keysAndValues[i], keysAndValues[i+1])
+		} else {
+			// Unpaired value
+			fmt.Fprintf(&b, " !BADKEY=%v", keysAndValues[i])
+		}
+	}
+
+	s.logger.Output(depth+2, b.String())
+}
@@ -0,0 +1,239 @@
+package internal
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Redacted replaces sensitive values in log output and formatted SDK types
+const Redacted = "[REDACTED]"
+
+// JSON field names that always contain secrets or PII
+var sensitiveFields = []string{
+	"account_number",
+	"cvv",
+	"dob",
+	"external_processor_token",
+	"link_token",
+	"pin_change_token",
+	"plaid_processor_token",
+	"routing_number",
+	"ssn",
+	"token",
+}
+
+// Log keys containing any of these words are redacted
+var sensitiveKeys = []string{"authorization", "cvv", "dob", "hmac", "password", "secret", "ssn", "token"}
+
+var (
+	// Signed JWTs (header.payload.signature)
+	jwtPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	// Bearer authorization values
+	bearerPattern = regexp.MustCompile(`(?i)(bearer\s+)\S+`)
+	// Sensitive string values within JSON bodies
+	jsonFieldPattern = regexp.MustCompile(`("(?:` + strings.Join(sensitiveFields, "|") + `)"\s*:\s*)"[^"]*"`)
+)
+
+// Checks whether a struct field must be redacted. The `redact:"true"` struct tag marks a field as
+// sensitive and `redact:"false"` opts out, otherwise the field's JSON name is checked against the policy
+func isSensitiveField(field reflect.StructField) bool {
+	if tag, ok := field.Tag.Lookup("redact"); ok {
+		return tag == "true"
+	}
+
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" {
+		name = strings.ToLower(field.Name)
+	}
+
+	return slices.Contains(sensitiveFields, name)
+}
+
+// FormatRedacted implements `fmt.Formatter` for struct types containing sensitive fields. The output
+// matches the `%v`, `%+v` and `%#v` formatting of the fmt package, with sensitive values replaced.
+// Nested SDK types are formatted with their own Format method.
+func FormatRedacted(f fmt.State, verb rune, v interface{}) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			fmt.Fprint(f, "<nil>")
+			return
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		fmt.Fprint(f, Redacted)
+		return
+	}
+
+	var (
+		plus  = f.Flag('+')
+		sharp = f.Flag('#')
+		// Verb used for the field values
+		format = "%v"
+		sep    = " "
+	)
+	switch {
+	case sharp:
+		format, sep = "%#v", ", "
+		fmt.Fprint(f, rv.Type().String())
+	case plus:
+		format = "%+v"
+	}
+
+	fmt.Fprint(f, "{")
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		value := rv.Field(i)
+
+		if i > 0 {
+			fmt.Fprint(f, sep)
+		}
+		if plus || sharp {
+			fmt.Fprintf(f, "%s:", field.Name)
+		}
+
+		switch {
+		case isSensitiveField(field) && !value.IsZero():
+			if sharp {
+				fmt.Fprintf(f, "%q", Redacted)
+			} else {
+				fmt.Fprint(f, Redacted)
+			}
+		case value.CanInterface():
+			fmt.Fprintf(f, format, value.Interface())
+		default:
+			fmt.Fprintf(f, format, value)
+		}
+	}
+	fmt.Fprint(f, "}")
+}
+
+// RedactString masks signed JWTs, bearer tokens and sensitive JSON fields within a string
+func RedactString(s string) string {
+	s = jwtPattern.ReplaceAllString(s, Redacted)
+	s = bearerPattern.ReplaceAllString(s, "${1}"+Redacted)
+	return jsonFieldPattern.ReplaceAllString(s, `${1}"`+Redacted+`"`)
+}
+
+// Checks whether a log key refers to a sensitive value
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, k := range sensitiveKeys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redacts a single log value
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return RedactString(v)
+	case error:
+		// Keep the original value unless it needs to be masked
+		if s := v.Error(); RedactString(s) != s {
+			return RedactString(s)
+		}
+		return value
+	case fmt.Stringer:
+		if s := v.String(); RedactString(s) != s {
+			return RedactString(s)
+		}
+		return value
+	}
+
+	// Structs are formatted, which applies the redaction of SDK types
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Struct {
+		return RedactString(fmt.Sprintf("%+v", value))
+	}
+
+	return value
+}
+
+// RedactingLogger masks secrets and PII in all entries before passing them to the wrapped Logger
+type RedactingLogger struct {
+	logger Logger
+}
+
+// NewRedactingLogger wraps the given Logger. Loggers that are already redacting are returned as-is
+func NewRedactingLogger(logger Logger) Logger {
+	if _, ok := logger.(*RedactingLogger); ok {
+		return logger
+	}
+	return &RedactingLogger{logger: logger}
+}
+
+// Debug logs a redacted message at LevelDebug
+func (r *RedactingLogger) Debug(msg string, keysAndValues ...interface{}) {
+	r.log(LevelDebug, msg, keysAndValues)
+}
+
+// Info logs a redacted message at LevelInfo
+func (r *RedactingLogger) Info(msg string, keysAndValues ...interface{}) {
+	r.log(LevelInfo, msg, keysAndValues)
+}
+
+// Warn logs a redacted message at LevelWarn
+func (r *RedactingLogger) Warn(msg string, keysAndValues ...interface{}) {
+	r.log(LevelWarn, msg, keysAndValues)
+}
+
+// Error logs a redacted message at LevelError
+func (r *RedactingLogger) Error(msg string, keysAndValues ...interface{}) {
+	r.log(LevelError, msg, keysAndValues)
+}
+
+// Redacts the entry and passes it to the wrapped Logger
+func (r *RedactingLogger) log(level Level, msg string, keysAndValues []interface{}) {
+	msg, keysAndValues = RedactString(msg), redactKeysAndValues(keysAndValues)
+
+	// Skip this method and the exported method that called it
+	if l, ok := r.logger.(callerLogger); ok {
+		l.logDepth(2, level, msg, keysAndValues)
+		return
+	}
+
+	switch level {
+	case LevelDebug:
+		r.logger.Debug(msg, keysAndValues...)
+	case LevelInfo:
+		r.logger.Info(msg, keysAndValues...)
+	case LevelWarn:
+		r.logger.Warn(msg, keysAndValues...)
+	default:
+		r.logger.Error(msg, keysAndValues...)
+	}
+}
+
+// Returns a copy of the key/value pairs with sensitive values masked
+func redactKeysAndValues(keysAndValues []interface{}) []interface{} {
+	redacted := make([]interface{}, len(keysAndValues))
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 >= len(keysAndValues) {
+			// Unpaired value
+			redacted[i] = redactValue(keysAndValues[i])
+			break
+		}
+
+		redacted[i] = keysAndValues[i]
+		if key, ok := keysAndValues[i].(string); ok && isSensitiveKey(key) {
+			redacted[i+1] = Redacted
+		} else {
+			redacted[i+1] = redactValue(keysAndValues[i+1])
+		}
+	}
+	return redacted
+}
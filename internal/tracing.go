@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name reported with all SDK spans
+const TracerName = "github.com/rizefinance/rize-go-sdk"
+
+// Span attributes specific to the Rize SDK
+const (
+	AttrService    = attribute.Key("rize.service")
+	AttrOperation  = attribute.Key("rize.operation")
+	AttrRetryCount = attribute.Key("rize.retry_count")
+	AttrErrorCode  = attribute.Key("rize.error_code")
+)
+
+// Tracer returns the SDK tracer from the given provider, falling back to the global TracerProvider
+func Tracer(provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(TracerName, trace.WithInstrumentationVersion(SDKVersion))
+}
+
+// Propagator returns the given propagator, falling back to the global TextMapPropagator
+func Propagator(propagator propagation.TextMapPropagator) propagation.TextMapPropagator {
+	if propagator == nil {
+		return otel.GetTextMapPropagator()
+	}
+	return propagator
+}
@@ -0,0 +1,163 @@
+package rize
+
+import (
+	"context"
+)
+
+// IteratorOptions configure how an Iterator walks the pages of a List endpoint
+type IteratorOptions struct {
+	// Maximum number of items to return. Zero returns all items
+	MaxItems int
+	// Fetch the next page in the background while the current page is being consumed
+	Prefetch bool
+}
+
+// PageFunc fetches a single page of results starting at the given offset
+type PageFunc[T any] func(ctx context.Context, offset int) ([]T, *ListResponse, error)
+
+// Iterator walks all pages of a List endpoint, fetching pages as needed.
+//
+//	it := rc.Customers.Iterate(ctx, &rize.CustomerListParams{Limit: 100}, nil)
+//	for it.Next() {
+//		customer := it.Item()
+//	}
+//	if err := it.Err(); err != nil { ... }
+//
+// An Iterator is not safe for concurrent use.
+type Iterator[T any] struct {
+	ctx   context.Context
+	fetch PageFunc[T]
+	opts  IteratorOptions
+	// Offset of the next page to fetch
+	offset int
+	// Current page and position within it
+	items []T
+	index int
+	item  T
+	// Number of items returned so far
+	count int
+	// Set once the last page has been fetched
+	done bool
+	err  error
+	// Page being fetched in the background
+	next chan pageResult[T]
+}
+
+// Result of fetching a single page
+type pageResult[T any] struct {
+	items []T
+	page  *ListResponse
+	err   error
+}
+
+// NewIterator creates an Iterator that uses fetch to retrieve each page, starting at the given offset
+func NewIterator[T any](ctx context.Context, offset int, fetch PageFunc[T], opts *IteratorOptions) *Iterator[T] {
+	it := &Iterator[T]{
+		ctx:    ctx,
+		fetch:  fetch,
+		offset: offset,
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	return it
+}
+
+// Next advances the iterator to the next item, fetching the next page if necessary. It returns false
+// when all items have been returned, MaxItems is reached, the context is done or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.opts.MaxItems > 0 && it.count >= it.opts.MaxItems {
+		return false
+	}
+
+	for it.index >= len(it.items) {
+		if it.done {
+			return false
+		}
+
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		res := it.nextPage()
+		if res.err != nil {
+			it.err = res.err
+			return false
+		}
+
+		it.items = res.items
+		it.index = 0
+		it.offset += len(res.items)
+		it.done = len(res.items) == 0 || res.page == nil || it.offset >= res.page.TotalCount
+
+		if !it.done && it.opts.Prefetch && (it.opts.MaxItems == 0 || it.count+len(it.items) < it.opts.MaxItems) {
+			it.prefetch()
+		}
+	}
+
+	it.item = it.items[it.index]
+	it.index++
+	it.count++
+
+	return true
+}
+
+// Item returns the current item
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All consumes the remaining items and returns them as a slice
+func (it *Iterator[T]) All() ([]T, error) {
+	var items []T
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// ListAll fetches all pages using the given PageFunc and returns the combined items
+func ListAll[T any](ctx context.Context, offset int, fetch PageFunc[T], opts *IteratorOptions) ([]T, error) {
+	return NewIterator(ctx, offset, fetch, opts).All()
+}
+
+// Returns the prefetched page, or fetches the next page
+func (it *Iterator[T]) nextPage() pageResult[T] {
+	if it.next != nil {
+		next := it.next
+		it.next = nil
+
+		select {
+		case res := <-next:
+			return res
+		case <-it.ctx.Done():
+			return pageResult[T]{err: it.ctx.Err()}
+		}
+	}
+
+	items, page, err := it.fetch(it.ctx, it.offset)
+	return pageResult[T]{items: items, page: page, err: err}
+}
+
+// Starts fetching the next page in the background
+func (it *Iterator[T]) prefetch() {
+	next := make(chan pageResult[T], 1)
+	offset := it.offset
+
+	go func() {
+		items, page, err := it.fetch(it.ctx, offset)
+		next <- pageResult[T]{items: items, page: page, err: err}
+	}()
+
+	it.next = next
+}
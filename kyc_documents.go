@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
@@ -22,7 +21,7 @@ type KYCDocument struct {
 	Filename  string    `json:"filename,omitempty"`
 	Note      string    `json:"note,omitempty"`
 	Extension string    `json:"extension,omitempty"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // KYCDocumentListParams builds the query parameters used in querying KYCDocuments
@@ -46,7 +45,7 @@ type KYCDocumentListResponse struct {
 }
 
 // List retrieves a list of KYC Documents for a given evaluation
-func (k *kycDocumentService) List(ctx context.Context, params *KYCDocumentListParams) (*KYCDocumentListResponse, error) {
+func (k *kycDocumentService) List(ctx context.Context, params *KYCDocumentListParams, opts ...CallOption) (*KYCDocumentListResponse, error) {
 	if params.EvaluationUID == "" {
 		return nil, fmt.Errorf("EvaluationUID is required")
 	}
@@ -56,19 +55,8 @@ func (k *kycDocumentService) List(ctx context.Context, params *KYCDocumentListPa
 		return nil, err
 	}
 
-	res, err := k.client.doRequest(ctx, http.MethodGet, "kyc_documents", v, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &KYCDocumentListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := k.client.doRequest(ctx, newOperation("KYCDocuments.List", http.MethodGet, "kyc_documents"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -76,7 +64,7 @@ func (k *kycDocumentService) List(ctx context.Context, params *KYCDocumentListPa
 }
 
 // Upload a KYC Document for review
-func (k *kycDocumentService) Upload(ctx context.Context, params *KYCDocumentUploadParams) (*KYCDocument, error) {
+func (k *kycDocumentService) Upload(ctx context.Context, params *KYCDocumentUploadParams, opts ...CallOption) (*KYCDocument, error) {
 	if params.EvaluationUID == "" ||
 		params.Filename == "" ||
 		params.FileContent == "" ||
@@ -90,19 +78,8 @@ func (k *kycDocumentService) Upload(ctx context.Context, params *KYCDocumentUplo
 		return nil, err
 	}
 
-	res, err := k.client.doRequest(ctx, http.MethodPost, "kyc_documents", nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &KYCDocument{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := k.client.doRequest(ctx, newOperation("KYCDocuments.Upload", http.MethodPost, "kyc_documents"), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -110,24 +87,13 @@ func (k *kycDocumentService) Upload(ctx context.Context, params *KYCDocumentUplo
 }
 
 // Get is used to retrieve metadata for a KYC Document previously uploaded
-func (k *kycDocumentService) Get(ctx context.Context, uid string) (*KYCDocument, error) {
+func (k *kycDocumentService) Get(ctx context.Context, uid string, opts ...CallOption) (*KYCDocument, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := k.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("kyc_documents/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &KYCDocument{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := k.client.doRequest(ctx, newOperation("KYCDocuments.Get", http.MethodGet, "kyc_documents/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -135,13 +101,13 @@ func (k *kycDocumentService) Get(ctx context.Context, uid string) (*KYCDocument,
 }
 
 // View is used to retrieve a KYC Document (image, PDF, etc) previously uploaded
-func (k *kycDocumentService) View(ctx context.Context, uid string) (*http.Response, error) {
+func (k *kycDocumentService) View(ctx context.Context, uid string, opts ...CallOption) (*http.Response, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
 	// TODO: Does this require a different Accept header type (image/png)?
-	res, err := k.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("kyc_documents/%s/view", uid), nil, nil)
+	res, err := k.client.doRequest(ctx, newOperation("KYCDocuments.View", http.MethodGet, "kyc_documents/{uid}/view", uid), nil, nil, nil, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,33 @@
+package rize
+
+import (
+	"log"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// Logger is a levelled, structured logger used by the SDK. Each message is followed by alternating
+// keys and values. `*slog.Logger` satisfies this interface, see `NewSlogLogger`
+type Logger = internal.Logger
+
+// Level is the severity of a log entry
+type Level = internal.Level
+
+// Supported log levels. Values match the levels of the `log/slog` package
+const (
+	LevelDebug = internal.LevelDebug
+	LevelInfo  = internal.LevelInfo
+	LevelWarn  = internal.LevelWarn
+	LevelError = internal.LevelError
+)
+
+// NewStdLogger creates a Logger that writes entries at or above `level` to the given standard
+// library `*log.Logger`. Entries are formatted as `[LEVEL] message key=value ...`
+func NewStdLogger(logger *log.Logger, level Level) Logger {
+	return internal.NewStdLogger(logger, level)
+}
+
+// NopLogger returns a Logger that discards all log entries
+func NopLogger() Logger {
+	return internal.NopLogger{}
+}
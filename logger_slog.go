@@ -0,0 +1,15 @@
+//go:build go1.21
+
+package rize
+
+import (
+	"log/slog"
+)
+
+// NewSlogLogger adapts a `*slog.Logger` for use as the SDK Logger. A nil logger uses `slog.Default()`
+func NewSlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return logger
+}
@@ -0,0 +1,74 @@
+package rize
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// MetricsRecorder receives measurements for every API call and auth token refresh. Implementations
+// must be safe for concurrent use.
+type MetricsRecorder interface {
+	// RecordRequest is called once an API call completes, including all retries
+	RecordRequest(m RequestMetrics)
+	// RecordTokenRefresh is called each time a new auth token is fetched from the API
+	RecordTokenRefresh(m TokenRefreshMetrics)
+}
+
+// RequestMetrics describes a completed API call
+type RequestMetrics struct {
+	// Logical operation, e.g. `Transfers.Create`
+	Operation string
+	// HTTP method
+	Method string
+	// HTTP status of the final response. Zero if no response was received
+	Status int
+	// Total time spent on the call, including retries and backoff
+	Duration time.Duration
+	// Number of retries after the initial attempt
+	Retries int
+	// Error returned to the caller, if any
+	Err error
+}
+
+// TokenRefreshMetrics describes an auth token refresh
+type TokenRefreshMetrics struct {
+	// Time spent fetching the token
+	Duration time.Duration
+	// Error returned by the auth endpoint, if any
+	Err error
+}
+
+// NopMetricsRecorder returns a MetricsRecorder that discards all measurements
+func NopMetricsRecorder() MetricsRecorder {
+	return nopMetricsRecorder{}
+}
+
+// Discards all measurements
+type nopMetricsRecorder struct{}
+
+// RecordRequest discards the measurement
+func (nopMetricsRecorder) RecordRequest(m RequestMetrics) {}
+
+// RecordTokenRefresh discards the measurement
+func (nopMetricsRecorder) RecordTokenRefresh(m TokenRefreshMetrics) {}
+
+// Reports a completed API call to the MetricsRecorder
+func (rc *Client) recordRequest(op *Operation, start time.Time, res *http.Response, err error) {
+	m := RequestMetrics{
+		Operation: op.Name,
+		Method:    op.Method,
+		Duration:  time.Since(start),
+		Retries:   op.retries(),
+		Err:       err,
+	}
+
+	var rerr *Error
+	if errors.As(err, &rerr) {
+		m.Status = rerr.Status
+	} else if res != nil {
+		m.Status = res.StatusCode
+	}
+
+	rc.cfg.Metrics.RecordRequest(m)
+}
@@ -0,0 +1,199 @@
+package rize
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLatencyBuckets are the upper bounds (in seconds) of the request duration histogram buckets
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// PrometheusRecorder is a MetricsRecorder that aggregates measurements in memory and exposes them in
+// the Prometheus text format. Serve it on your metrics endpoint, or use WriteTo to add the metrics to
+// an existing exporter. The following metrics are provided, prefixed with the namespace:
+//
+//	requests_total{operation,status}             Counter of completed API calls
+//	request_duration_seconds{operation}          Histogram of API call latency
+//	request_retries_total{operation}             Counter of retried attempts
+//	token_refreshes_total{result}                Counter of auth token refreshes
+//
+// The status label is the HTTP status code, or `error` if no response was received.
+type PrometheusRecorder struct {
+	namespace string
+	buckets   []float64
+
+	mu        sync.Mutex
+	requests  map[[2]string]uint64
+	durations map[string]*histogram
+	retries   map[string]uint64
+	refreshes map[string]uint64
+}
+
+// Cumulative histogram data
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// NewPrometheusRecorder creates a PrometheusRecorder. The namespace defaults to `rize` and the
+// latency buckets default to DefaultLatencyBuckets
+func NewPrometheusRecorder(namespace string, buckets []float64) *PrometheusRecorder {
+	if namespace == "" {
+		namespace = "rize"
+	}
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	return &PrometheusRecorder{
+		namespace: namespace,
+		buckets:   buckets,
+		requests:  map[[2]string]uint64{},
+		durations: map[string]*histogram{},
+		retries:   map[string]uint64{},
+		refreshes: map[string]uint64{},
+	}
+}
+
+// RecordRequest adds the API call to the request metrics
+func (p *PrometheusRecorder) RecordRequest(m RequestMetrics) {
+	status := "error"
+	if m.Status != 0 {
+		status = strconv.Itoa(m.Status)
+	}
+	seconds := m.Duration.Seconds()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests[[2]string{m.Operation, status}]++
+	p.retries[m.Operation] += uint64(m.Retries)
+
+	h, ok := p.durations[m.Operation]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.durations[m.Operation] = h
+	}
+	for i, bound := range p.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+// RecordTokenRefresh adds the token refresh to the refresh counter
+func (p *PrometheusRecorder) RecordTokenRefresh(m TokenRefreshMetrics) {
+	result := "success"
+	if m.Err != nil {
+		result = "error"
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.refreshes[result]++
+}
+
+// ServeHTTP writes all metrics in the Prometheus text format
+func (p *PrometheusRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	p.WriteTo(w)
+}
+
+// WriteTo writes all metrics in the Prometheus text format
+func (p *PrometheusRecorder) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	name := p.namespace + "_requests_total"
+	fmt.Fprintf(cw, "# HELP %s Completed Rize API calls.\n# TYPE %s counter\n", name, name)
+	keys := make([][2]string, 0, len(p.requests))
+	for key := range p.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+	for _, key := range keys {
+		fmt.Fprintf(cw, "%s{operation=%s,status=%s} %d\n", name, quoteLabel(key[0]), quoteLabel(key[1]), p.requests[key])
+	}
+
+	name = p.namespace + "_request_duration_seconds"
+	fmt.Fprintf(cw, "# HELP %s Latency of Rize API calls, including retries.\n# TYPE %s histogram\n", name, name)
+	for _, op := range sortedKeys(p.durations) {
+		h := p.durations[op]
+		for i, bound := range p.buckets {
+			fmt.Fprintf(cw, "%s_bucket{operation=%s,le=\"%s\"} %d\n", name, quoteLabel(op), strconv.FormatFloat(bound, 'g', -1, 64), h.counts[i])
+		}
+		fmt.Fprintf(cw, "%s_bucket{operation=%s,le=\"+Inf\"} %d\n", name, quoteLabel(op), h.count)
+		fmt.Fprintf(cw, "%s_sum{operation=%s} %s\n", name, quoteLabel(op), strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(cw, "%s_count{operation=%s} %d\n", name, quoteLabel(op), h.count)
+	}
+
+	name = p.namespace + "_request_retries_total"
+	fmt.Fprintf(cw, "# HELP %s Retried Rize API call attempts.\n# TYPE %s counter\n", name, name)
+	for _, op := range sortedKeys(p.retries) {
+		fmt.Fprintf(cw, "%s{operation=%s} %d\n", name, quoteLabel(op), p.retries[op])
+	}
+
+	name = p.namespace + "_token_refreshes_total"
+	fmt.Fprintf(cw, "# HELP %s Rize auth token refreshes.\n# TYPE %s counter\n", name, name)
+	for _, result := range sortedKeys(p.refreshes) {
+		fmt.Fprintf(cw, "%s{result=%s} %d\n", name, quoteLabel(result), p.refreshes[result])
+	}
+
+	if err := cw.w.Flush(); err != nil && cw.err == nil {
+		cw.err = err
+	}
+	return cw.n, cw.err
+}
+
+// Returns the sorted keys of a map
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Quotes a label value, escaping backslashes, double quotes and line feeds
+func quoteLabel(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+// Counts the bytes written and keeps the first error
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	if err != nil {
+		c.err = err
+	}
+	return n, err
+}
@@ -0,0 +1,41 @@
+package rize
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Request is a single API call passed through the Middleware chain
+type Request struct {
+	// Logical operation being called
+	Operation *Operation
+	// Typed params of the call (e.g. `*CustomerListParams`), or nil if the call has none
+	Params interface{}
+	// Query string params
+	Query url.Values
+	// Encoded JSON request body, or nil
+	Body []byte
+	// Additional headers sent with the request. They replace the SDK's default headers of the same name
+	Header http.Header
+	// Value the response body is decoded into (e.g. `*Customer`), or nil
+	Result interface{}
+}
+
+// Handler sends a Request to the API and returns its Response. It must return a non-nil Response
+// or an error
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a Handler to run code before and after API calls. A Middleware may modify the
+// Request (e.g. to add headers), inspect the Response, or return its own Response or error without
+// calling next (e.g. for caching or fault injection). A Response without an HTTPResponse is given
+// one built from its StatusCode (200 if not set), Header and Body
+type Middleware func(next Handler) Handler
+
+// Wraps the handler in the middleware, with the first middleware being the outermost
+func chain(handler Handler, middleware []Middleware) Handler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](handler)
+	}
+	return handler
+}
@@ -0,0 +1,224 @@
+package rize
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact decimal amount, such as a USD amount. Amounts keep the number of decimal places
+// they were created with (e.g. `12.30`), and arithmetic never rounds. The zero Money is 0.
+//
+// Money is sent to the API as a JSON string, and decoded from both JSON strings and numbers
+type Money struct {
+	// Unscaled value, nil for zero. The amount is units / 10^scale
+	units *big.Int
+	// Number of decimal places
+	scale int
+}
+
+// Decimal amounts, with an optional sign, fraction and exponent
+var moneyPattern = regexp.MustCompile(`^([+-]?)(\d*)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$`)
+
+// NewMoney returns the amount units / 10^scale, e.g. `NewMoney(1234, 2)` is 12.34
+func NewMoney(units int64, scale int) Money {
+	if scale < 0 {
+		return Money{units: new(big.Int).Mul(big.NewInt(units), pow10(-scale))}
+	}
+	return Money{units: big.NewInt(units), scale: scale}
+}
+
+// ParseMoney parses a decimal amount such as `12.34`, `-0.5` or `1e3`
+func ParseMoney(s string) (Money, error) {
+	m := moneyPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || m[2]+m[3] == "" {
+		return Money{}, fmt.Errorf("rize: invalid amount %q", s)
+	}
+
+	units, ok := new(big.Int).SetString(m[2]+m[3], 10)
+	if !ok {
+		return Money{}, fmt.Errorf("rize: invalid amount %q", s)
+	}
+	if m[1] == "-" {
+		units.Neg(units)
+	}
+
+	scale := len(m[3])
+	if m[4] != "" {
+		exp, err := strconv.Atoi(m[4])
+		if err != nil || exp > 1000 || exp < -1000 {
+			return Money{}, fmt.Errorf("rize: invalid amount %q", s)
+		}
+		scale -= exp
+	}
+	if scale < 0 {
+		units.Mul(units, pow10(-scale))
+		scale = 0
+	}
+
+	return Money{units: units, scale: scale}, nil
+}
+
+// MustParseMoney is like ParseMoney, but panics if the amount is invalid
+func MustParseMoney(s string) Money {
+	m, err := ParseMoney(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Returns 10^n
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// Returns the unscaled value, never nil
+func (m Money) value() *big.Int {
+	if m.units == nil {
+		return new(big.Int)
+	}
+	return m.units
+}
+
+// Returns the unscaled value at a larger scale
+func (m Money) rescale(scale int) *big.Int {
+	if scale == m.scale {
+		return m.value()
+	}
+	return new(big.Int).Mul(m.value(), pow10(scale-m.scale))
+}
+
+// Returns the larger scale of the two amounts
+func maxScale(a Money, b Money) int {
+	if a.scale > b.scale {
+		return a.scale
+	}
+	return b.scale
+}
+
+// Add returns m + o
+func (m Money) Add(o Money) Money {
+	scale := maxScale(m, o)
+	return Money{units: new(big.Int).Add(m.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// Sub returns m - o
+func (m Money) Sub(o Money) Money {
+	scale := maxScale(m, o)
+	return Money{units: new(big.Int).Sub(m.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return Money{units: new(big.Int).Neg(m.value()), scale: m.scale}
+}
+
+// Abs returns the absolute value of m
+func (m Money) Abs() Money {
+	return Money{units: new(big.Int).Abs(m.value()), scale: m.scale}
+}
+
+// Cmp compares the amounts and returns -1 if m < o, 0 if m == o and +1 if m > o
+func (m Money) Cmp(o Money) int {
+	scale := maxScale(m, o)
+	return m.rescale(scale).Cmp(o.rescale(scale))
+}
+
+// Equal reports whether the amounts are equal, regardless of their decimal places
+func (m Money) Equal(o Money) bool {
+	return m.Cmp(o) == 0
+}
+
+// Sign returns -1 if m < 0, 0 if m == 0 and +1 if m > 0
+func (m Money) Sign() int {
+	return m.value().Sign()
+}
+
+// IsZero reports whether the amount is 0
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Round returns the amount rounded to the given number of decimal places, with halves rounded away
+// from zero
+func (m Money) Round(places int) Money {
+	if places < 0 {
+		places = 0
+	}
+	if places >= m.scale {
+		return Money{units: m.rescale(places), scale: places}
+	}
+
+	divisor := pow10(m.scale - places)
+	quo, rem := new(big.Int).QuoRem(m.value(), divisor, new(big.Int))
+	// Round away from zero when the remainder is at least half the divisor
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(divisor) >= 0 {
+		quo.Add(quo, big.NewInt(int64(m.Sign())))
+	}
+
+	return Money{units: quo, scale: places}
+}
+
+// String returns the amount with its decimal places, e.g. `12.30`
+func (m Money) String() string {
+	digits := new(big.Int).Abs(m.value()).String()
+	if m.scale > 0 {
+		if len(digits) <= m.scale {
+			digits = strings.Repeat("0", m.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-m.scale] + "." + digits[len(digits)-m.scale:]
+	}
+	if m.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// StringFixed returns the amount rounded to the given number of decimal places, e.g. `12.35` for
+// `StringFixed(2)` of 12.345
+func (m Money) StringFixed(places int) string {
+	return m.Round(places).String()
+}
+
+// MarshalJSON encodes the amount as a JSON string
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON decodes a JSON string or number. Null and empty strings decode to zero
+func (m *Money) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*m = Money{}
+		return nil
+	}
+
+	s := string(b)
+	if len(b) > 0 && b[0] == '"' {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*m = Money{}
+			return nil
+		}
+	}
+
+	money, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
+
+// EncodeValues adds the amount to query string params
+func (m Money) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, m.String())
+	return nil
+}
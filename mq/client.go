@@ -2,14 +2,18 @@ package mq
 
 import (
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/go-stomp/stomp/v3"
 	"github.com/rizefinance/rize-go-sdk/internal"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
 )
 
+// Logger is a levelled, structured logger used by the MQ client. It is the same type as `rize.Logger`
+type Logger = internal.Logger
+
 // Service type to store the client reference
 type service struct {
 	client *Client
@@ -20,15 +24,28 @@ type Config struct {
 	// Message queue username
 	Username string
 	// Message queue password
-	Password string
+	Password string `redact:"true"`
 	// Message queue topic
 	ClientID string
 	// Rize infrastructure target environment. Defaults to `sandbox``
 	Environment string
-	// Enable debug logging
+	// Source of the tracer used to create a span for every message received (optional). Defaults to
+	// the global OpenTelemetry TracerProvider
+	TracerProvider trace.TracerProvider
+	// Extracts the producer's trace context from message headers (optional). Defaults to the global
+	// OpenTelemetry TextMapPropagator
+	Propagator propagation.TextMapPropagator
+	// Structured logger for SDK log output (optional). Any `rize.Logger` can be used
+	Logger Logger
+	// Enable debug logging to stderr when no Logger is provided
 	Debug bool
 }
 
+// Format masks the password when the Config is printed
+func (cfg Config) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, cfg)
+}
+
 // Client is the top-level interface for the RMQ
 type Client struct {
 	// All configuration values
@@ -39,24 +56,27 @@ type Client struct {
 	Connection *stomp.Conn
 	// Message queue service
 	MessageQueue *messageQueueService
+	// Creates a span for every message received
+	tracer trace.Tracer
+	// Extracts trace context from message headers
+	propagator propagation.TextMapPropagator
 }
 
 // NewClient initializes the RMQ Client
 func NewClient(cfg *Config) (*Client, error) {
-	// Enable debug logging
-	internal.EnableLogging(cfg.Debug)
-
-	log.Println("Creating MQ client...")
-
 	// Validate client config
 	if err := cfg.validateConfig(); err != nil {
 		return nil, err
 	}
 
+	cfg.Logger.Debug("Creating MQ client", "environment", cfg.Environment)
+
 	rc := &Client{}
 	rc.cfg = cfg
 	rc.Endpoint = fmt.Sprintf("mq-%s.newline53.com:61614", cfg.Environment)
 	rc.MessageQueue = &messageQueueService{client: rc}
+	rc.tracer = internal.Tracer(cfg.TracerProvider)
+	rc.propagator = internal.Propagator(cfg.Propagator)
 
 	return rc, nil
 }
@@ -75,8 +95,10 @@ func (cfg *Config) validateConfig() error {
 		return fmt.Errorf("Config error: ClientID is required")
 	}
 
+	cfg.Logger = internal.DefaultLogger(cfg.Logger, cfg.Debug)
+
 	if ok := slices.Contains(internal.Environments, strings.ToLower(cfg.Environment)); !ok {
-		log.Printf("Environment %s not recognized. Defaulting to sandbox...\n", cfg.Environment)
+		cfg.Logger.Warn("Environment not recognized, defaulting to sandbox", "environment", cfg.Environment)
 		cfg.Environment = "sandbox"
 	}
 
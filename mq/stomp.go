@@ -1,13 +1,17 @@
 package mq
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/go-stomp/stomp/v3"
+	"github.com/go-stomp/stomp/v3/frame"
 	"github.com/rizefinance/rize-go-sdk/internal"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
 )
 
@@ -33,7 +37,7 @@ func (m *messageQueueService) Connect() error {
 		return err
 	}
 
-	log.Println("Connection Successful")
+	m.client.cfg.Logger.Debug("Connection successful", "endpoint", m.client.Endpoint)
 
 	m.client.Connection = conn
 
@@ -57,7 +61,7 @@ func (m *messageQueueService) Subscribe(topic string, subscriptionName string) (
 		return nil, err
 	}
 
-	log.Println("Subscribed to topic", topic)
+	m.client.cfg.Logger.Debug("Subscribed to topic", "topic", topic, "subscription", subscriptionName)
 
 	return sub, err
 }
@@ -68,7 +72,94 @@ func (m *messageQueueService) Unsubscribe(sub *stomp.Subscription) error {
 		return err
 	}
 
-	log.Println("Unsubscribed successfully")
+	m.client.cfg.Logger.Debug("Unsubscribed successfully")
 
 	return nil
 }
+
+// MessageHandler processes a single message received from a subscription. The context carries the
+// span created for the message
+type MessageHandler func(ctx context.Context, msg *stomp.Message) error
+
+// Listen receives messages from the subscription and passes each one to the handler, until the context
+// is done or the subscription is closed. A span is created for every message, continuing the trace
+// context found in the message headers. Errors returned by the handler are recorded on the span.
+// Returns an error if the subscription reports one.
+func (m *messageQueueService) Listen(ctx context.Context, sub *stomp.Subscription, handler MessageHandler) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-sub.C:
+			if !ok {
+				return nil
+			}
+			if err := m.handleMessage(ctx, msg, handler); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Runs the handler for a single message within a span
+func (m *messageQueueService) handleMessage(ctx context.Context, msg *stomp.Message, handler MessageHandler) error {
+	if msg.Header != nil {
+		ctx = m.client.propagator.Extract(ctx, headerCarrier{msg.Header})
+	}
+
+	ctx, span := m.client.tracer.Start(ctx, fmt.Sprintf("%s receive", msg.Destination),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("stomp"),
+			semconv.MessagingOperationReceive,
+			semconv.MessagingDestinationNameKey.String(msg.Destination),
+		),
+	)
+	defer span.End()
+
+	if msg.Header != nil {
+		if id := msg.Header.Get(frame.MessageId); id != "" {
+			span.SetAttributes(semconv.MessagingMessageIDKey.String(id))
+		}
+	}
+
+	// Subscription errors are terminal
+	if msg.Err != nil {
+		span.RecordError(msg.Err)
+		span.SetStatus(codes.Error, msg.Err.Error())
+		return msg.Err
+	}
+
+	if err := handler(ctx, msg); err != nil {
+		m.client.cfg.Logger.Warn("Message handler failed", "destination", msg.Destination, "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return nil
+}
+
+// Adapts STOMP frame headers for use with an OpenTelemetry propagator
+type headerCarrier struct {
+	header *frame.Header
+}
+
+// Get returns the value of the header
+func (c headerCarrier) Get(key string) string {
+	return c.header.Get(key)
+}
+
+// Set replaces the value of the header
+func (c headerCarrier) Set(key string, value string) {
+	c.header.Set(key, value)
+}
+
+// Keys lists the header keys
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, c.header.Len())
+	for i := 0; i < c.header.Len(); i++ {
+		key, _ := c.header.GetAt(i)
+		keys = append(keys, key)
+	}
+	return keys
+}
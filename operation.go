@@ -0,0 +1,62 @@
+package rize
+
+import (
+	"strings"
+)
+
+// Operation describes a single Platform API call
+type Operation struct {
+	// Logical name of the operation, e.g. `Transfers.Create`
+	Name string
+	// HTTP method
+	Method string
+	// Path template relative to the API base path, e.g. `customers/{uid}`
+	PathTemplate string
+	// Request path with the path params filled in, e.g. `customers/EhrQZJNjCd79LLYq`
+	Path string
+	// Number of attempts made to send the request, including retries
+	attempts int
+	// Send the request once, leaving retries to the caller
+	noRetry bool
+}
+
+// Creates an Operation, filling each `{param}` placeholder of the path template with the given
+// params in order
+func newOperation(name string, method string, template string, params ...string) *Operation {
+	path := template
+	for _, param := range params {
+		start := strings.Index(path, "{")
+		end := strings.Index(path, "}")
+		if start < 0 || end < start {
+			break
+		}
+		path = path[:start] + param + path[end+1:]
+	}
+
+	return &Operation{
+		Name:         name,
+		Method:       method,
+		PathTemplate: template,
+		Path:         path,
+	}
+}
+
+// Service returns the name of the service the operation belongs to, e.g. `Transfers`
+func (op *Operation) Service() string {
+	service, _, _ := strings.Cut(op.Name, ".")
+	return service
+}
+
+// Group returns the endpoint group of the operation, the first segment of the path (e.g. `customers`)
+func (op *Operation) Group() string {
+	group, _, _ := strings.Cut(op.PathTemplate, "/")
+	return group
+}
+
+// Returns the number of retries after the initial attempt
+func (op *Operation) retries() int {
+	if op.attempts < 1 {
+		return 0
+	}
+	return op.attempts - 1
+}
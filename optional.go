@@ -0,0 +1,161 @@
+package rize
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Optional is a param that is either unset, set to a value (which may be the zero value) or
+// explicitly null. The zero Optional is unset.
+//
+// Unset params are left out of query strings and request bodies. Null params are sent as `null`
+// in request bodies and as an empty value in query strings, which clears the field
+type Optional[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// Some returns an Optional set to v
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, set: true}
+}
+
+// Null returns an explicitly null Optional
+func Null[T any]() Optional[T] {
+	return Optional[T]{null: true}
+}
+
+// Get returns the value and whether it is set
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// Value returns the value, or the zero value of T when unset or null
+func (o Optional[T]) Value() T {
+	return o.value
+}
+
+// IsSet reports whether the Optional holds a value
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsNull reports whether the Optional is explicitly null
+func (o Optional[T]) IsNull() bool {
+	return o.null
+}
+
+// IsZero reports whether the Optional is unset, i.e. neither a value nor null
+func (o Optional[T]) IsZero() bool {
+	return !o.set && !o.null
+}
+
+// String formats the value, or returns `null` or an empty string
+func (o Optional[T]) String() string {
+	switch {
+	case o.set:
+		return fmt.Sprint(o.value)
+	case o.null:
+		return "null"
+	}
+	return ""
+}
+
+// MarshalJSON encodes the value, or null when unset or null. Request params using Optional fields
+// leave unset fields out of the body entirely
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as a null Optional and anything else as a value
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Null[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// EncodeValues implements query.Encoder
+func (o Optional[T]) EncodeValues(key string, v *url.Values) error {
+	switch {
+	case o.set:
+		if e, ok := any(o.value).(query.Encoder); ok {
+			return e.EncodeValues(key, v)
+		}
+		v.Add(key, fmt.Sprint(o.value))
+	case o.null:
+		v.Add(key, "")
+	}
+	return nil
+}
+
+// The interface shared by all Optional types
+type optional interface {
+	IsZero() bool
+	IsNull() bool
+}
+
+// Values that can report whether they are set, such as Date or NullTime
+type zeroer interface {
+	IsZero() bool
+}
+
+// marshalOmitUnset encodes the struct v as JSON, leaving out any Optional fields that are unset, and
+// fields tagged `omitempty` whose IsZero method reports true (which encoding/json ignores for structs).
+// v must not have a MarshalJSON method, so callers pass a type alias of their params
+func marshalOmitUnset(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	var unset []string
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		z, ok := rv.Field(i).Interface().(zeroer)
+		if !ok || !z.IsZero() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if _, isOptional := z.(optional); !isOptional && !strings.Contains(opts, "omitempty") {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		unset = append(unset, name)
+	}
+	if len(unset) == 0 {
+		return data, nil
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for _, name := range unset {
+		delete(fields, name)
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,91 @@
+package rize
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures the Client created by NewClient. A *Config is also an Option: passed first, it is
+// used as the Client's configuration; passed later, it replaces all values set by earlier options
+type Option interface {
+	apply(cfg *Config)
+}
+
+// Option implemented by a function
+type optionFunc func(cfg *Config)
+
+func (fn optionFunc) apply(cfg *Config) {
+	fn(cfg)
+}
+
+// Copies the configuration values
+func (c *Config) apply(cfg *Config) {
+	if c != nil {
+		*cfg = *c.clone()
+	}
+}
+
+// WithCredentials sets the Program UID and HMAC key for the target environment
+func WithCredentials(programUID string, hmacKey string) Option {
+	return optionFunc(func(cfg *Config) {
+		cfg.ProgramUID = programUID
+		cfg.HMACKey = hmacKey
+	})
+}
+
+// WithEnvironment sets the Rize infrastructure target environment (e.g. `sandbox`)
+func WithEnvironment(environment string) Option {
+	return optionFunc(func(cfg *Config) {
+		cfg.Environment = environment
+	})
+}
+
+// WithBaseURL overrides the API base URL, e.g. for local testing
+func WithBaseURL(baseURL string) Option {
+	return optionFunc(func(cfg *Config) {
+		cfg.BaseURL = baseURL
+	})
+}
+
+// WithHTTPClient sets the http.Client used to send API requests
+func WithHTTPClient(httpClient *http.Client) Option {
+	return optionFunc(func(cfg *Config) {
+		cfg.HTTPClient = httpClient
+	})
+}
+
+// WithLogger sets the structured logger for SDK log output
+func WithLogger(logger Logger) Option {
+	return optionFunc(func(cfg *Config) {
+		cfg.Logger = logger
+	})
+}
+
+// WithRetryPolicy sets the retry behavior for transient errors
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return optionFunc(func(cfg *Config) {
+		cfg.RetryPolicy = policy
+	})
+}
+
+// WithTimeout sets the timeout for each API request attempt. It only applies when no HTTPClient is provided
+func WithTimeout(timeout time.Duration) Option {
+	return optionFunc(func(cfg *Config) {
+		cfg.Timeout = timeout
+	})
+}
+
+// WithMiddleware appends Middleware to run around every API call
+func WithMiddleware(middleware ...Middleware) Option {
+	return optionFunc(func(cfg *Config) {
+		cfg.Middleware = append(cfg.Middleware, middleware...)
+	})
+}
+
+// WithLazyAuth defers fetching the first auth token until the first API call, so the Client can be
+// created while the API is unreachable
+func WithLazyAuth() Option {
+	return optionFunc(func(cfg *Config) {
+		cfg.LazyAuth = true
+	})
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
@@ -17,19 +16,19 @@ type pinwheelJobService service
 
 // PinwheelJob data type
 type PinwheelJob struct {
-	UID                  string    `json:"uid,omitempty"`
-	SyntheticAccountUID  string    `json:"synthetic_account_uid,omitempty"`
-	Status               string    `json:"status,omitempty"`
-	CreatedAt            time.Time `json:"created_at,omitempty"`
-	StatusUpdatedAt      time.Time `json:"status_updated_at,omitempty"`
-	CustomerUID          string    `json:"customer_uid,omitempty"`
-	LinkToken            string    `json:"link_token,omitempty"`
-	ExpiresAt            time.Time `json:"expires_at,omitempty"`
-	JobNames             []string  `json:"job_names,omitempty"`
-	Amount               int       `json:"amount,omitempty"`
-	DisablePartialSwitch bool      `json:"disable_partial_switch,omitempty"`
-	OrganizationName     string    `json:"organization_name,omitempty"`
-	SkipWelcomeScreen    bool      `json:"skip_welcome_screen,omitempty"`
+	UID                  string            `json:"uid,omitempty"`
+	SyntheticAccountUID  string            `json:"synthetic_account_uid,omitempty"`
+	Status               PinwheelJobStatus `json:"status,omitempty"`
+	CreatedAt            time.Time         `json:"created_at"`
+	StatusUpdatedAt      NullTime          `json:"status_updated_at"`
+	CustomerUID          string            `json:"customer_uid,omitempty"`
+	LinkToken            string            `json:"link_token,omitempty"`
+	ExpiresAt            NullTime          `json:"expires_at"`
+	JobNames             []string          `json:"job_names,omitempty"`
+	Amount               int               `json:"amount,omitempty"`
+	DisablePartialSwitch bool              `json:"disable_partial_switch,omitempty"`
+	OrganizationName     string            `json:"organization_name,omitempty"`
+	SkipWelcomeScreen    bool              `json:"skip_welcome_screen,omitempty"`
 }
 
 // PinwheelJobListParams builds the query parameters used in querying Pinwheel Jobs
@@ -57,34 +56,48 @@ type PinwheelJobListResponse struct {
 }
 
 // List retrieves a list of Pinwheel Jobs filtered by the given parameters
-func (p *pinwheelJobService) List(ctx context.Context, params *PinwheelJobListParams) (*PinwheelJobListResponse, error) {
+func (p *pinwheelJobService) List(ctx context.Context, params *PinwheelJobListParams, opts ...CallOption) (*PinwheelJobListResponse, error) {
 	// Build PinwheelJobListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := p.client.doRequest(ctx, http.MethodGet, "pinwheel_jobs", v, nil)
-	if err != nil {
+	response := &PinwheelJobListResponse{}
+	if _, err := p.client.doRequest(ctx, newOperation("PinwheelJobs.List", http.MethodGet, "pinwheel_jobs"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &PinwheelJobListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Pinwheel Jobs matching the given parameters, fetching additional pages as needed
+func (p *pinwheelJobService) Iterate(ctx context.Context, params *PinwheelJobListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*PinwheelJob] {
+	if params == nil {
+		params = &PinwheelJobListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*PinwheelJob, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := p.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Pinwheel Jobs matching the given parameters across all pages
+func (p *pinwheelJobService) ListAll(ctx context.Context, params *PinwheelJobListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*PinwheelJob, error) {
+	return p.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Create is used to initialize a new Pinwheel Job and return a pinwheel_link_token to be used with the Pinwheel Link SDK
-func (p *pinwheelJobService) Create(ctx context.Context, params *PinwheelJobCreateParams) (*PinwheelJob, error) {
+func (p *pinwheelJobService) Create(ctx context.Context, params *PinwheelJobCreateParams, opts ...CallOption) (*PinwheelJob, error) {
 	if len(params.JobNames) == 0 || params.SyntheticAccountUID == "" {
 		return nil, fmt.Errorf("JobNames and SyntheticAccountUID are required")
 	}
@@ -94,19 +107,8 @@ func (p *pinwheelJobService) Create(ctx context.Context, params *PinwheelJobCrea
 		return nil, err
 	}
 
-	res, err := p.client.doRequest(ctx, http.MethodPost, "pinwheel_jobs", nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &PinwheelJob{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := p.client.doRequest(ctx, newOperation("PinwheelJobs.Create", http.MethodPost, "pinwheel_jobs"), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -114,24 +116,13 @@ func (p *pinwheelJobService) Create(ctx context.Context, params *PinwheelJobCrea
 }
 
 // Get returns a single PinwheelJob
-func (p *pinwheelJobService) Get(ctx context.Context, uid string) (*PinwheelJob, error) {
+func (p *pinwheelJobService) Get(ctx context.Context, uid string, opts ...CallOption) (*PinwheelJob, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := p.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("pinwheel_jobs/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &PinwheelJob{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := p.client.doRequest(ctx, newOperation("PinwheelJobs.Get", http.MethodGet, "pinwheel_jobs/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
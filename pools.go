@@ -2,9 +2,7 @@ package rize
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -36,51 +34,54 @@ type PoolListResponse struct {
 }
 
 // List retrieves a list of Pools filtered by the given parameters
-func (p *poolService) List(ctx context.Context, params *PoolListParams) (*PoolListResponse, error) {
+func (p *poolService) List(ctx context.Context, params *PoolListParams, opts ...CallOption) (*PoolListResponse, error) {
 	// Build PoolListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := p.client.doRequest(ctx, http.MethodGet, "pools", v, nil)
-	if err != nil {
+	response := &PoolListResponse{}
+	if _, err := p.client.doRequest(ctx, newOperation("Pools.List", http.MethodGet, "pools"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &PoolListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Pools matching the given parameters, fetching additional pages as needed
+func (p *poolService) Iterate(ctx context.Context, params *PoolListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*Pool] {
+	if params == nil {
+		params = &PoolListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*Pool, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := p.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Pools matching the given parameters across all pages
+func (p *poolService) ListAll(ctx context.Context, params *PoolListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*Pool, error) {
+	return p.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Get returns a single Pool
-func (p *poolService) Get(ctx context.Context, uid string) (*Pool, error) {
+func (p *poolService) Get(ctx context.Context, uid string, opts ...CallOption) (*Pool, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := p.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("pools/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Pool{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := p.client.doRequest(ctx, newOperation("Pools.Get", http.MethodGet, "pools/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
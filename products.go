@@ -2,9 +2,7 @@ package rize
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -48,25 +46,14 @@ type ProductListResponse struct {
 }
 
 // List retrieves a list of Products filtered by the given parameters
-func (p *productService) List(ctx context.Context, params *ProductListParams) (*ProductListResponse, error) {
+func (p *productService) List(ctx context.Context, params *ProductListParams, opts ...CallOption) (*ProductListResponse, error) {
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := p.client.doRequest(ctx, http.MethodGet, "products", v, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProductListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := p.client.doRequest(ctx, newOperation("Products.List", http.MethodGet, "products"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -74,24 +61,13 @@ func (p *productService) List(ctx context.Context, params *ProductListParams) (*
 }
 
 // Get returns a single Product
-func (p *productService) Get(ctx context.Context, uid string) (*Product, error) {
+func (p *productService) Get(ctx context.Context, uid string, opts ...CallOption) (*Product, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := p.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("products/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Product{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := p.client.doRequest(ctx, newOperation("Products.Get", http.MethodGet, "products/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,219 @@
+package rize
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// RateLimit configures client-side throttling of API requests. Requests block until they are allowed
+// to proceed or their context is done. When the API responds with 429, the request rate is halved and
+// no requests are sent until the `Retry-After` time has passed. The rate recovers with every
+// successful response.
+//
+// Token refreshes (the `auth` endpoint group) are not limited unless `auth` is configured in Groups, so
+// they never delay the API calls waiting for them.
+type RateLimit struct {
+	// Sustained number of requests per second. Zero disables the request rate limit
+	RequestsPerSecond float64
+	// Number of requests that can be sent at once before the rate limit applies. Defaults to 1
+	Burst int
+	// Maximum number of requests in flight at the same time. Zero allows any number of requests
+	MaxInFlight int
+	// Separate limits for endpoint groups, keyed by the first segment of the path (e.g. `customers`,
+	// `transactions`). Requests to other endpoints share the limits above
+	Groups map[string]*RateLimit
+}
+
+// Endpoint group of token refreshes, which share no limits with other requests
+const authGroup = "auth"
+
+// Limits that apply to a single endpoint group
+type limiter struct {
+	// Nil when there is no request rate limit
+	bucket *tokenBucket
+	// Nil when there is no concurrency limit
+	slots chan struct{}
+}
+
+// Throttles requests using the configured RateLimit
+type rateLimiter struct {
+	shared *limiter
+	groups map[string]*limiter
+}
+
+// Creates a rateLimiter for the given limits. Returns nil if no limits are configured
+func newRateLimiter(cfg *RateLimit) *rateLimiter {
+	if cfg == nil {
+		return nil
+	}
+
+	r := &rateLimiter{
+		shared: newLimiter(cfg),
+		groups: map[string]*limiter{},
+	}
+	for group, groupCfg := range cfg.Groups {
+		if groupCfg != nil {
+			r.groups[group] = newLimiter(groupCfg)
+		}
+	}
+
+	return r
+}
+
+// Creates the limits for a single endpoint group
+func newLimiter(cfg *RateLimit) *limiter {
+	l := &limiter{}
+	if cfg.RequestsPerSecond > 0 {
+		burst := cfg.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		l.bucket = newTokenBucket(cfg.RequestsPerSecond, burst)
+	}
+	if cfg.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, cfg.MaxInFlight)
+	}
+	return l
+}
+
+// Returns the limits for the endpoint group, or nil if the group is not limited
+func (r *rateLimiter) limiter(group string) *limiter {
+	if l, ok := r.groups[group]; ok {
+		return l
+	}
+	if group == authGroup {
+		return nil
+	}
+	return r.shared
+}
+
+// Blocks until the request may be sent. The returned function must be called with the response (or
+// nil) once the request completes, to free its in-flight slot and adapt the rate to 429 responses
+func (r *rateLimiter) acquire(ctx context.Context, group string) (func(*http.Response), error) {
+	if r == nil {
+		return func(*http.Response) {}, nil
+	}
+
+	l := r.limiter(group)
+	if l == nil {
+		return func(*http.Response) {}, nil
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func(res *http.Response) {
+		if l.slots != nil {
+			<-l.slots
+		}
+		if l.bucket != nil && res != nil {
+			l.bucket.observe(res, time.Now())
+		}
+	}
+
+	if l.bucket != nil {
+		wait := l.bucket.reserve(time.Now())
+		if wait > 0 {
+			// Fail immediately if the request cannot be sent before the context deadline
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+				l.bucket.cancel()
+				release(nil)
+				return nil, ErrRateLimitWait
+			}
+			if err := sleep(ctx, wait); err != nil {
+				l.bucket.cancel()
+				release(nil)
+				return nil, err
+			}
+		}
+	}
+
+	return release, nil
+}
+
+// Token bucket that halves its rate when the API responds with 429 and gradually recovers
+type tokenBucket struct {
+	mu sync.Mutex
+	// Current and configured rates in tokens per second
+	rate    float64
+	maxRate float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	// No requests are allowed before this time, set by the `Retry-After` header of a 429 response
+	pausedUntil time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:    rate,
+		maxRate: rate,
+		burst:   float64(burst),
+		tokens:  float64(burst),
+		last:    time.Now(),
+	}
+}
+
+// Adds the tokens accumulated since the last update
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate)
+	}
+	b.last = now
+}
+
+// Takes a token and returns how long to wait before it may be used
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.tokens--
+
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	if pause := b.pausedUntil.Sub(now); pause > wait {
+		wait = pause
+	}
+
+	return wait
+}
+
+// Returns a reserved token that was not used
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// Slows down after a 429 response and speeds up again after other responses
+func (b *tokenBucket) observe(res *http.Response, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+
+	if res.StatusCode != http.StatusTooManyRequests {
+		b.rate = math.Min(b.maxRate, b.rate+b.maxRate*internal.RateLimitRecoveryFraction)
+		return
+	}
+
+	b.rate = math.Max(b.maxRate*internal.RateLimitMinFraction, b.rate/2)
+	if d, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+		if until := now.Add(d); until.After(b.pausedUntil) {
+			b.pausedUntil = until
+		}
+	}
+}
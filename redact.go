@@ -0,0 +1,71 @@
+package rize
+
+import (
+	"fmt"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// Sensitive values are masked whenever these types are printed with the fmt package (`%v`, `%+v`,
+// `%#v`) or passed to the configured Logger. Fields are redacted by JSON name (ssn, dob, cvv,
+// account_number, routing_number and tokens) or with the `redact:"true"` struct tag.
+
+// Format masks the HMAC key when the Config is printed
+func (cfg Config) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, cfg)
+}
+
+// Format masks the token when the TokenCache is printed
+func (tc TokenCache) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, tc)
+}
+
+// Format masks the token when the AuthTokenResponse is printed
+func (a AuthTokenResponse) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, a)
+}
+
+// Format masks the SSN and date of birth when the CustomerDetails are printed
+func (c CustomerDetails) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, c)
+}
+
+// Format masks the account and routing numbers when the CustodialAccount is printed
+func (c CustodialAccount) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, c)
+}
+
+// Format masks the account and routing numbers when the SyntheticAccount is printed
+func (sa SyntheticAccount) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, sa)
+}
+
+// Format masks the account and routing numbers and processor tokens when the params are printed
+func (sa SyntheticAccountCreateParams) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, sa)
+}
+
+// Format masks the CVV when the params are printed
+func (d DebitCardActivateParams) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, d)
+}
+
+// Format masks the token when the DebitCardAccessToken is printed
+func (d DebitCardAccessToken) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, d)
+}
+
+// Format masks the PIN change token when the response is printed
+func (d DebitCardPINTokenResponse) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, d)
+}
+
+// Format masks the token when the params are printed
+func (v VirtualDebitCardQueryParams) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, v)
+}
+
+// Format masks the link token when the PinwheelJob is printed
+func (p PinwheelJob) Format(f fmt.State, verb rune) {
+	internal.FormatRedacted(f, verb, p)
+}
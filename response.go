@@ -0,0 +1,153 @@
+package rize
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// Response is the result of an API call passed back through the Middleware chain. It also carries
+// the response metadata, which callers can obtain with the WithResponse CallOption
+type Response struct {
+	// Raw HTTP response. Nil if the response was produced by a Middleware
+	HTTPResponse *http.Response
+	// Raw HTTP request of the final attempt. Nil if the response was produced by a Middleware
+	HTTPRequest *http.Request
+	// Response body
+	Body []byte
+	// Decoded response body, which is Request.Result once decoded. If a Middleware returns a Response
+	// with a nil Result, the Body is decoded into Request.Result
+	Result interface{}
+	// HTTP status code
+	StatusCode int
+	// Response headers
+	Header http.Header
+	// Request ID assigned by the API, if any. Include this when contacting Rize support
+	RequestID string
+	// Rate limit headers of the response
+	RateLimit RateLimitStatus
+	// Duration of the call, including authentication, retries and middleware
+	Duration time.Duration
+	// Number of attempts made to send the request, including retries
+	Attempts int
+}
+
+// RateLimitStatus holds the rate limit headers of an API response. Fields are zero when the header
+// is not present
+type RateLimitStatus struct {
+	// Maximum number of requests allowed in the current window (`X-RateLimit-Limit`)
+	Limit int
+	// Number of requests remaining in the current window (`X-RateLimit-Remaining`)
+	Remaining int
+	// Time at which the current window resets (`X-RateLimit-Reset`)
+	Reset time.Time
+	// Time to wait before making another request (`Retry-After`)
+	RetryAfter time.Duration
+}
+
+// DeleteResult is the result of a Delete (archive) request, which has no response body
+type DeleteResult struct {
+	// UID of the archived resource
+	UID string
+	// Response metadata, including the status code and request ID
+	Response *Response
+}
+
+// WithResponse copies the metadata of the call's response (status code, headers, request ID, rate
+// limits and timing) into resp. When the call fails with an API error, the status code and request
+// ID of the error response are set
+func WithResponse(resp *Response) CallOption {
+	return func(opts *callOptions) {
+		opts.responses = append(opts.responses, resp)
+	}
+}
+
+// Builds the Response for an API response and its body
+func newResponse(res *http.Response, body []byte) *Response {
+	return &Response{
+		HTTPResponse: res,
+		HTTPRequest:  res.Request,
+		Body:         body,
+		StatusCode:   res.StatusCode,
+		Header:       res.Header,
+		RequestID:    requestID(res.Header),
+		RateLimit:    parseRateLimitStatus(res.Header),
+	}
+}
+
+// Fills in the HTTPResponse of a response returned by a middleware without calling the API, so
+// methods returning the raw *http.Response can read and close its Body
+func (r *Response) ensureHTTPResponse() {
+	if r.HTTPResponse != nil {
+		return
+	}
+
+	if r.StatusCode == 0 {
+		r.StatusCode = http.StatusOK
+	}
+	if r.Header == nil {
+		r.Header = http.Header{}
+	}
+	r.HTTPResponse = &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.Header,
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       r.HTTPRequest,
+	}
+}
+
+// Returns the request ID assigned by the API
+func requestID(header http.Header) string {
+	for _, h := range internal.RequestIDHeaders {
+		if id := header.Get(h); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// Parses the rate limit headers. The reset time may be given in Unix seconds or in seconds from now
+func parseRateLimitStatus(header http.Header) RateLimitStatus {
+	var status RateLimitStatus
+	status.Limit, _ = strconv.Atoi(header.Get(internal.RateLimitLimitHeader))
+	status.Remaining, _ = strconv.Atoi(header.Get(internal.RateLimitRemainingHeader))
+	if reset, err := strconv.ParseInt(header.Get(internal.RateLimitResetHeader), 10, 64); err == nil {
+		if reset > 1e9 {
+			status.Reset = time.Unix(reset, 0)
+		} else {
+			status.Reset = time.Now().Add(time.Duration(reset) * time.Second)
+		}
+	}
+	status.RetryAfter, _ = parseRetryAfter(header.Get("Retry-After"))
+	return status
+}
+
+// Fills in the metadata of a call for the WithResponse CallOption
+func captureResponse(dst *Response, resp *Response, err error, op *Operation, duration time.Duration) {
+	if resp != nil {
+		*dst = *resp
+	} else {
+		*dst = Response{}
+	}
+
+	var rerr *Error
+	if errors.As(err, &rerr) {
+		dst.StatusCode = rerr.Status
+		dst.RequestID = rerr.RequestID
+		dst.Body = rerr.Body
+	}
+
+	dst.Duration = duration
+	dst.Attempts = op.attempts
+}
@@ -0,0 +1,145 @@
+package rize
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// RetryPolicy configures how requests that fail with a transient error are retried.
+// Only safe methods (GET, HEAD, OPTIONS) and requests carrying an idempotency key are retried.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the initial request. A value of 1 or less disables retries
+	MaxAttempts int
+	// Delay before the first retry. The delay doubles after every attempt
+	BaseBackoff time.Duration
+	// Upper limit for the delay between two attempts. Requests are not retried when the `Retry-After`
+	// header asks for a longer delay
+	MaxBackoff time.Duration
+	// Fraction (0-1) of each delay that is randomized to spread out retries from concurrent callers
+	Jitter float64
+	// Ignore the `Retry-After` header returned by the API and always use the computed backoff
+	IgnoreRetryAfter bool
+}
+
+// DefaultRetryPolicy returns the retry policy used when Config.RetryPolicy is not set
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: internal.RetryMaxAttempts,
+		BaseBackoff: internal.RetryBaseBackoff,
+		MaxBackoff:  internal.RetryMaxBackoff,
+		Jitter:      internal.RetryJitter,
+	}
+}
+
+// Fill in any missing policy values with the defaults
+func (p *RetryPolicy) withDefaults() *RetryPolicy {
+	policy := *p
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	if policy.BaseBackoff <= 0 {
+		policy.BaseBackoff = internal.RetryBaseBackoff
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = internal.RetryMaxBackoff
+	}
+	if policy.Jitter < 0 {
+		policy.Jitter = 0
+	} else if policy.Jitter > 1 {
+		policy.Jitter = 1
+	}
+	return &policy
+}
+
+// Checks whether a failed attempt can be safely sent again
+func (p *RetryPolicy) shouldRetry(ctx context.Context, req *http.Request, res *http.Response, err error) bool {
+	// Never retry once the caller has given up on the request
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if !isIdempotent(req) {
+		return false
+	}
+
+	// Transport errors (connection reset, timeout, etc) are always considered transient
+	if err != nil {
+		return true
+	}
+
+	return (&Error{Status: res.StatusCode}).Retryable()
+}
+
+// Calculates how long to wait before the next attempt. Returns false if the `Retry-After` header
+// asks for a delay longer than MaxBackoff
+func (p *RetryPolicy) delay(attempt int, res *http.Response) (time.Duration, bool) {
+	if !p.IgnoreRetryAfter && res != nil {
+		if d, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return d, d <= p.MaxBackoff
+		}
+	}
+
+	// Exponential backoff: BaseBackoff * 2^(attempt-1), capped at MaxBackoff
+	backoff := float64(p.BaseBackoff) * math.Pow(2, float64(attempt-1))
+	if backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	// Remove a random portion of the delay
+	backoff -= backoff * p.Jitter * rand.Float64()
+
+	return time.Duration(backoff), true
+}
+
+// Requests are idempotent if they use a safe method or carry an idempotency key
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return req.Header.Get(internal.IdempotencyKeyHeader) != ""
+}
+
+// Parses a `Retry-After` header value in either delay-seconds or HTTP-date format
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		d := time.Until(date)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// Waits for the given duration or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
@@ -14,16 +13,26 @@ type sandboxService service
 
 // SandboxCreateParams are the body params used when creating a new Sandbox transaction
 type SandboxCreateParams struct {
-	TransactionType  string  `json:"transaction_type"`
-	CustomerUID      string  `json:"customer_uid"`
-	DebitCardUID     string  `json:"debit_card_uid"`
-	DenialReason     string  `json:"denial_reason,omitempty"`
-	USDollarAmount   float64 `json:"us_dollar_amount"`
-	Mcc              string  `json:"mcc,omitempty"`
-	MerchantLocation string  `json:"merchant_location,omitempty"`
-	MerchantName     string  `json:"merchant_name,omitempty"`
-	MerchantNumber   string  `json:"merchant_number,omitempty"`
-	Description      string  `json:"description,omitempty"`
+	TransactionType  SandboxTransactionType `json:"transaction_type"`
+	CustomerUID      string                 `json:"customer_uid"`
+	DebitCardUID     string                 `json:"debit_card_uid"`
+	DenialReason     string                 `json:"denial_reason,omitempty"`
+	USDollarAmount   Money                  `json:"us_dollar_amount"`
+	Mcc              string                 `json:"mcc,omitempty"`
+	MerchantLocation string                 `json:"merchant_location,omitempty"`
+	MerchantName     string                 `json:"merchant_name,omitempty"`
+	MerchantNumber   string                 `json:"merchant_number,omitempty"`
+	Description      string                 `json:"description,omitempty"`
+}
+
+// MarshalJSON sends USDollarAmount as a JSON number, as expected by the API
+func (p SandboxCreateParams) MarshalJSON() ([]byte, error) {
+	// Alias without the MarshalJSON method
+	type params SandboxCreateParams
+	return json.Marshal(struct {
+		params
+		USDollarAmount json.Number `json:"us_dollar_amount"`
+	}{params(p), json.Number(p.USDollarAmount.String())})
 }
 
 // SandboxResponse is an API response
@@ -32,11 +41,11 @@ type SandboxResponse struct {
 }
 
 // Create a Transaction by simulating the attributes that would be expected from reading an actual transaction received from a third party system
-func (s *sandboxService) Create(ctx context.Context, params *SandboxCreateParams) (*SandboxResponse, error) {
+func (s *sandboxService) Create(ctx context.Context, params *SandboxCreateParams, opts ...CallOption) (*SandboxResponse, error) {
 	if params.TransactionType == "" ||
 		params.CustomerUID == "" ||
 		params.DebitCardUID == "" ||
-		params.USDollarAmount == 0 {
+		params.USDollarAmount.IsZero() {
 		return nil, fmt.Errorf("TransactionType, CustomerUID, DebitCardUID and USDollarAmount are required")
 	}
 
@@ -45,19 +54,8 @@ func (s *sandboxService) Create(ctx context.Context, params *SandboxCreateParams
 		return nil, err
 	}
 
-	res, err := s.client.doRequest(ctx, http.MethodPost, "sandbox/mock_transactions", nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &SandboxResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := s.client.doRequest(ctx, newOperation("Sandbox.Create", http.MethodPost, "sandbox/mock_transactions"), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
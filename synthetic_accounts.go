@@ -5,9 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -24,18 +22,18 @@ type SyntheticAccount struct {
 	CustomerUID                 string                          `json:"customer_uid,omitempty"`
 	SyntheticAccountTypeUID     string                          `json:"synthetic_account_type_uid,omitempty"`
 	SyntheticAccountCategory    string                          `json:"synthetic_account_category,omitempty"`
-	Status                      string                          `json:"status,omitempty"`
+	Status                      SyntheticAccountStatus          `json:"status,omitempty"`
 	Liability                   bool                            `json:"liability,omitempty"`
-	NetUSDBalance               string                          `json:"net_usd_balance,omitempty"`
-	NetUSDPendingBalance        string                          `json:"net_usd_pending_balance,omitempty"`
-	NetUSDAvailableBalance      string                          `json:"net_usd_available_balance,omitempty"`
+	NetUSDBalance               Money                           `json:"net_usd_balance"`
+	NetUSDPendingBalance        Money                           `json:"net_usd_pending_balance"`
+	NetUSDAvailableBalance      Money                           `json:"net_usd_available_balance"`
 	AssetBalances               []*SyntheticAccountAssetBalance `json:"asset_balances,omitempty"`
 	MasterAccount               bool                            `json:"master_account,omitempty"`
 	AccountNumber               string                          `json:"account_number,omitempty"`
 	AccountNumberLastFour       string                          `json:"account_number_last_four,omitempty"`
 	RoutingNumber               string                          `json:"routing_number,omitempty"`
-	OpenedAt                    time.Time                       `json:"opened_at,omitempty"`
-	ClosedAt                    time.Time                       `json:"closed_at,omitempty"`
+	OpenedAt                    NullTime                        `json:"opened_at"`
+	ClosedAt                    NullTime                        `json:"closed_at"`
 	ClosedToSyntheticAccountUID string                          `json:"closed_to_synthetic_account_uid,omitempty"`
 }
 
@@ -43,7 +41,7 @@ type SyntheticAccount struct {
 type SyntheticAccountAssetBalance struct {
 	AssetQuantity        string `json:"asset_quantity,omitempty"`
 	AssetType            string `json:"asset_type,omitempty"`
-	CurrentUSDValue      string `json:"current_usd_value,omitempty"`
+	CurrentUSDValue      Money  `json:"current_usd_value"`
 	CustodialAccountUID  string `json:"custodial_account_uid,omitempty"`
 	CustodialAccountName string `json:"custodial_account_name,omitempty"`
 	Debit                bool   `json:"debit,omitempty"`
@@ -61,16 +59,16 @@ type SyntheticAccountType struct {
 
 // SyntheticAccountListParams builds the query parameters used in querying Synthetic Accounts
 type SyntheticAccountListParams struct {
-	CustomerUID              string `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
-	ExternalUID              string `url:"external_uid,omitempty" json:"external_uid,omitempty"`
-	PoolUID                  string `url:"pool_uid,omitempty" json:"pool_uid,omitempty"`
-	Limit                    int    `url:"limit,omitempty" json:"limit,omitempty"`
-	Offset                   int    `url:"offset,omitempty" json:"offset,omitempty"`
-	SyntheticAccountTypeUID  string `url:"synthetic_account_type_uid,omitempty" json:"synthetic_account_type_uid,omitempty"`
-	SyntheticAccountCategory string `url:"synthetic_account_category,omitempty" json:"synthetic_account_category,omitempty"`
-	Liability                bool   `url:"liability,omitempty" json:"liability,omitempty"`
-	Status                   string `url:"status,omitempty" json:"status,omitempty"`
-	Sort                     string `url:"sort,omitempty" json:"sort,omitempty"`
+	CustomerUID              string                 `url:"customer_uid,omitempty" json:"customer_uid,omitempty"`
+	ExternalUID              string                 `url:"external_uid,omitempty" json:"external_uid,omitempty"`
+	PoolUID                  string                 `url:"pool_uid,omitempty" json:"pool_uid,omitempty"`
+	Limit                    int                    `url:"limit,omitempty" json:"limit,omitempty"`
+	Offset                   int                    `url:"offset,omitempty" json:"offset,omitempty"`
+	SyntheticAccountTypeUID  string                 `url:"synthetic_account_type_uid,omitempty" json:"synthetic_account_type_uid,omitempty"`
+	SyntheticAccountCategory string                 `url:"synthetic_account_category,omitempty" json:"synthetic_account_category,omitempty"`
+	Liability                Optional[bool]         `url:"liability,omitempty" json:"liability,omitempty"`
+	Status                   SyntheticAccountStatus `url:"status,omitempty" json:"status,omitempty"`
+	Sort                     string                 `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // SyntheticAccountCreateParams are the body params used when creating a new Synthetic Account
@@ -87,8 +85,15 @@ type SyntheticAccountCreateParams struct {
 
 // SyntheticAccountUpdateParams are the body params used when updating a Synthetic Account
 type SyntheticAccountUpdateParams struct {
-	Name string `json:"name,omitempty"`
-	Note string `json:"note,omitempty"`
+	Name Optional[string] `json:"name,omitempty"`
+	Note Optional[string] `json:"note,omitempty"`
+}
+
+// MarshalJSON leaves unset fields out of the request body
+func (p SyntheticAccountUpdateParams) MarshalJSON() ([]byte, error) {
+	// Alias without the MarshalJSON method
+	type params SyntheticAccountUpdateParams
+	return marshalOmitUnset(params(p))
 }
 
 // SyntheticAccountTypeListParams builds the query parameters used in querying Synthetic Account Types
@@ -111,34 +116,48 @@ type SyntheticAccountTypeListResponse struct {
 }
 
 // List retrieves a list of Synthetic Account filtered by the given parameters
-func (sa *syntheticAccountService) List(ctx context.Context, params *SyntheticAccountListParams) (*SyntheticAccountListResponse, error) {
+func (sa *syntheticAccountService) List(ctx context.Context, params *SyntheticAccountListParams, opts ...CallOption) (*SyntheticAccountListResponse, error) {
 	// Build SyntheticAccountListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := sa.client.doRequest(ctx, http.MethodGet, "synthetic_accounts", v, nil)
-	if err != nil {
+	response := &SyntheticAccountListResponse{}
+	if _, err := sa.client.doRequest(ctx, newOperation("SyntheticAccounts.List", http.MethodGet, "synthetic_accounts"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &SyntheticAccountListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Synthetic Accounts matching the given parameters, fetching additional pages as needed
+func (sa *syntheticAccountService) Iterate(ctx context.Context, params *SyntheticAccountListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*SyntheticAccount] {
+	if params == nil {
+		params = &SyntheticAccountListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*SyntheticAccount, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := sa.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Synthetic Accounts matching the given parameters across all pages
+func (sa *syntheticAccountService) ListAll(ctx context.Context, params *SyntheticAccountListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*SyntheticAccount, error) {
+	return sa.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Create a new Synthetic Account in the Pool with the provided specification
-func (sa *syntheticAccountService) Create(ctx context.Context, params *SyntheticAccountCreateParams) (*SyntheticAccount, error) {
+func (sa *syntheticAccountService) Create(ctx context.Context, params *SyntheticAccountCreateParams, opts ...CallOption) (*SyntheticAccount, error) {
 	if params.Name == "" || params.PoolUID == "" || params.SyntheticAccountTypeUID == "" {
 		return nil, fmt.Errorf("properties Name, PoolUID and SyntheticAccountTypeUID are required")
 	}
@@ -148,19 +167,8 @@ func (sa *syntheticAccountService) Create(ctx context.Context, params *Synthetic
 		return nil, err
 	}
 
-	res, err := sa.client.doRequest(ctx, http.MethodPost, "synthetic_accounts", nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &SyntheticAccount{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := sa.client.doRequest(ctx, newOperation("SyntheticAccounts.Create", http.MethodPost, "synthetic_accounts"), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -168,24 +176,13 @@ func (sa *syntheticAccountService) Create(ctx context.Context, params *Synthetic
 }
 
 // Get returns a single Synthetic Account resource along with supporting details and account balances
-func (sa *syntheticAccountService) Get(ctx context.Context, uid string) (*SyntheticAccount, error) {
+func (sa *syntheticAccountService) Get(ctx context.Context, uid string, opts ...CallOption) (*SyntheticAccount, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := sa.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("synthetic_accounts/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &SyntheticAccount{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := sa.client.doRequest(ctx, newOperation("SyntheticAccounts.Get", http.MethodGet, "synthetic_accounts/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -193,7 +190,7 @@ func (sa *syntheticAccountService) Get(ctx context.Context, uid string) (*Synthe
 }
 
 // Update the Synthetic Account metadata
-func (sa *syntheticAccountService) Update(ctx context.Context, uid string, params *SyntheticAccountUpdateParams) (*SyntheticAccount, error) {
+func (sa *syntheticAccountService) Update(ctx context.Context, uid string, params *SyntheticAccountUpdateParams, opts ...CallOption) (*SyntheticAccount, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
@@ -203,19 +200,8 @@ func (sa *syntheticAccountService) Update(ctx context.Context, uid string, param
 		return nil, err
 	}
 
-	res, err := sa.client.doRequest(ctx, http.MethodPut, fmt.Sprintf("synthetic_accounts/%s", uid), nil, bytes.NewBuffer(bytesMessage))
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &SyntheticAccount{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := sa.client.doRequest(ctx, newOperation("SyntheticAccounts.Update", http.MethodPut, "synthetic_accounts/{uid}", uid), params, nil, bytes.NewBuffer(bytesMessage), response, opts...); err != nil {
 		return nil, err
 	}
 
@@ -223,66 +209,69 @@ func (sa *syntheticAccountService) Update(ctx context.Context, uid string, param
 }
 
 // Delete will archive a Synthetic Account
-func (sa *syntheticAccountService) Delete(ctx context.Context, uid string) (*http.Response, error) {
+func (sa *syntheticAccountService) Delete(ctx context.Context, uid string, opts ...CallOption) (*DeleteResult, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := sa.client.doRequest(ctx, http.MethodDelete, fmt.Sprintf("synthetic_accounts/%s", uid), nil, nil)
-	if err != nil {
+	result := &DeleteResult{UID: uid, Response: &Response{}}
+	opts = append(opts, WithResponse(result.Response))
+	if _, err := sa.client.doRequest(ctx, newOperation("SyntheticAccounts.Delete", http.MethodDelete, "synthetic_accounts/{uid}", uid), nil, nil, nil, nil, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	return res, nil
+	return result, nil
 }
 
 // ListAccountTypes retrieves a list of Synthetic Account Types filtered by the given parameters
-func (sa *syntheticAccountService) ListAccountTypes(ctx context.Context, params *SyntheticAccountTypeListParams) (*SyntheticAccountTypeListResponse, error) {
+func (sa *syntheticAccountService) ListAccountTypes(ctx context.Context, params *SyntheticAccountTypeListParams, opts ...CallOption) (*SyntheticAccountTypeListResponse, error) {
 	// Build SyntheticAccountTypeListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := sa.client.doRequest(ctx, http.MethodGet, "synthetic_account_types", v, nil)
-	if err != nil {
+	response := &SyntheticAccountTypeListResponse{}
+	if _, err := sa.client.doRequest(ctx, newOperation("SyntheticAccounts.ListAccountTypes", http.MethodGet, "synthetic_account_types"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &SyntheticAccountTypeListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// IterateAccountTypes returns an Iterator over all Synthetic Account Types matching the given parameters, fetching additional pages as needed
+func (sa *syntheticAccountService) IterateAccountTypes(ctx context.Context, params *SyntheticAccountTypeListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*SyntheticAccountType] {
+	if params == nil {
+		params = &SyntheticAccountTypeListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*SyntheticAccountType, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := sa.ListAccountTypes(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAllAccountTypes retrieves all Synthetic Account Types matching the given parameters across all pages
+func (sa *syntheticAccountService) ListAllAccountTypes(ctx context.Context, params *SyntheticAccountTypeListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*SyntheticAccountType, error) {
+	return sa.IterateAccountTypes(ctx, params, opts, callOpts...).All()
 }
 
 // GetAccountType returns a single Synthetic Account Type resource along with supporting details
-func (sa *syntheticAccountService) GetAccountType(ctx context.Context, uid string) (*SyntheticAccountType, error) {
+func (sa *syntheticAccountService) GetAccountType(ctx context.Context, uid string, opts ...CallOption) (*SyntheticAccountType, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := sa.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("synthetic_account_types/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &SyntheticAccountType{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := sa.client.doRequest(ctx, newOperation("SyntheticAccounts.GetAccountType", http.MethodGet, "synthetic_account_types/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
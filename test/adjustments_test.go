@@ -14,7 +14,7 @@ var adjustment = &rize.Adjustment{
 	UID:                 "EhrQZJNjCd79LLYq",
 	ExternalUID:         "PT3sH7oxxQPwchrf",
 	CustomerUID:         "uKxmLxUEiSj5h4M3",
-	USDAdjustmentAmount: "2.43",
+	USDAdjustmentAmount: rize.MustParseMoney("2.43"),
 	CreatedAt:           time.Now(),
 	Status:              "initiated",
 	AdjustmentType: &rize.AdjustmentType{
@@ -41,8 +41,8 @@ func TestAdjustmentService_List(t *testing.T) {
 		CustomerUID:            "uKxmLxUEiSj5h4M3",
 		AdjustmentTypeUID:      "2Ej2tsFbQT3S1HYd",
 		ExternalUID:            "PT3sH7oxxQPwchrf",
-		USDAdjustmentAmountMax: 10,
-		USDAdjustmentAmountMin: 5,
+		USDAdjustmentAmountMax: rize.Some(rize.NewMoney(10, 0)),
+		USDAdjustmentAmountMin: rize.Some(rize.NewMoney(5, 0)),
 		Sort:                   "adjustment_type_name_asc",
 	}
 
@@ -60,7 +60,7 @@ func TestAdjustmentService_Create(t *testing.T) {
 	params := &rize.AdjustmentCreateParams{
 		ExternalUID:         "partner-generated-id",
 		CustomerUID:         "kaxHFJnWvJxRJZxq",
-		USDAdjustmentAmount: "2.43",
+		USDAdjustmentAmount: rize.MustParseMoney("2.43"),
 		AdjustmentTypeUID:   "KM2eKbR98t4tdAyZ",
 	}
 
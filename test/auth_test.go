@@ -2,10 +2,19 @@ package rize_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/rizefinance/rize-go-sdk"
+	"github.com/rizefinance/rize-go-sdk/internal"
 )
 
 // Complete AuthTokenResponse{} data
@@ -23,3 +32,292 @@ func TestAuthService_GetToken(t *testing.T) {
 		t.Fatalf(err.Error())
 	}
 }
+
+func TestAuthService_GetToken_Concurrent(t *testing.T) {
+	var (
+		authRequests int32
+		badTokens    int32
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+internal.BasePath+"/auth" {
+			atomic.AddInt32(&authRequests, 1)
+			// Keep the refresh in flight long enough for callers to pile up
+			time.Sleep(time.Millisecond * 50)
+			resp, _ := json.Marshal(tokenResponse)
+			w.Write(resp)
+			return
+		}
+		// The short-lived refresh token must never be used for API requests
+		if r.Header.Get("Authorization") != tokenResponse.Token {
+			atomic.AddInt32(&badTokens, 1)
+		}
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	client, err := rize.NewClient(&rize.Config{
+		ProgramUID: "program_uid",
+		HMACKey:    "hmac_key",
+		BaseURL:    server.URL,
+		Clock:      func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+
+	// Force all goroutines to find an expired token
+	now = now.Add(time.Hour * 24)
+	atomic.StoreInt32(&authRequests, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+				t.Error("Error fetching customer\n", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&authRequests); n != 1 {
+		t.Fatalf("Expected a single token refresh, got %d", n)
+	}
+	if n := atomic.LoadInt32(&badTokens); n != 0 {
+		t.Fatalf("Expected all requests to use the auth token, got %d with another token", n)
+	}
+}
+
+func TestAuthService_GetToken_CallerDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond * 100)
+		resp, _ := json.Marshal(tokenResponse)
+		w.Write(resp)
+	}))
+	defer server.Close()
+
+	client, err := rize.NewClient(&rize.Config{
+		ProgramUID: "program_uid",
+		HMACKey:    "hmac_key",
+		BaseURL:    server.URL,
+		LazyAuth:   true,
+	})
+	if err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+
+	// The first caller starts the refresh and gives up before it completes
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+	leader := make(chan error)
+	go func() {
+		_, err := client.Auth.GetToken(ctx)
+		leader <- err
+	}()
+	time.Sleep(time.Millisecond * 5)
+
+	// Callers waiting on the same refresh are not affected by the first caller's deadline
+	if _, err := client.Auth.GetToken(context.Background()); err != nil {
+		t.Fatal("Expected the shared refresh to succeed\n", err)
+	}
+	if err := <-leader; err != context.DeadlineExceeded {
+		t.Fatalf("Expected the first caller to time out, received %v", err)
+	}
+}
+
+// Create a test client with a controllable clock. Returns the client, a function to advance the
+// clock and the number of auth requests received so far.
+func newClockTestClient(t *testing.T, token func(now time.Time) string) (*rize.Client, func(time.Duration), *int32) {
+	t.Helper()
+
+	var (
+		authRequests int32
+		now          = time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	)
+	clock := func() time.Time { return now }
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+internal.BasePath+"/auth" {
+			atomic.AddInt32(&authRequests, 1)
+			resp, _ := json.Marshal(&rize.AuthTokenResponse{Token: token(now)})
+			w.Write(resp)
+			return
+		}
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := rize.NewClient(&rize.Config{
+		ProgramUID:         "program_uid",
+		HMACKey:            "hmac_key",
+		BaseURL:            server.URL,
+		TokenRefreshMargin: time.Minute * 5,
+		Clock:              clock,
+	})
+	if err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+
+	return client, func(d time.Duration) { now = now.Add(d) }, &authRequests
+}
+
+func TestAuthService_GetToken_ExpiryClaim(t *testing.T) {
+	// Issue tokens that expire after one hour
+	client, advance, authRequests := newClockTestClient(t, func(now time.Time) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"exp": now.Add(time.Hour).Unix(),
+		})
+		signed, _ := token.SignedString([]byte("secret"))
+		return signed
+	})
+
+	advance(time.Minute * 50)
+	if _, err := client.Auth.GetToken(context.Background()); err != nil {
+		t.Fatal("Error fetching Auth token\n", err)
+	}
+	if *authRequests != 1 {
+		t.Fatalf("Expected cached token to be used, got %d auth requests", *authRequests)
+	}
+
+	// Within the refresh margin of the `exp` claim
+	advance(time.Minute * 6)
+	if _, err := client.Auth.GetToken(context.Background()); err != nil {
+		t.Fatal("Error fetching Auth token\n", err)
+	}
+	if *authRequests != 2 {
+		t.Fatalf("Expected token to be refreshed before expiry, got %d auth requests", *authRequests)
+	}
+}
+
+func TestAuthService_GetToken_ShortLivedToken(t *testing.T) {
+	// Issue tokens that expire after four minutes, shorter than the refresh margin
+	client, advance, authRequests := newClockTestClient(t, func(now time.Time) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"exp": now.Add(time.Minute * 4).Unix(),
+		})
+		signed, _ := token.SignedString([]byte("secret"))
+		return signed
+	})
+
+	advance(time.Minute * 2)
+	if _, err := client.Auth.GetToken(context.Background()); err != nil {
+		t.Fatal("Error fetching Auth token\n", err)
+	}
+	if *authRequests != 1 {
+		t.Fatalf("Expected cached token to be used, got %d auth requests", *authRequests)
+	}
+
+	// Within a quarter of the token lifetime of its expiry
+	advance(time.Second * 90)
+	if _, err := client.Auth.GetToken(context.Background()); err != nil {
+		t.Fatal("Error fetching Auth token\n", err)
+	}
+	if *authRequests != 2 {
+		t.Fatalf("Expected token to be refreshed before expiry, got %d auth requests", *authRequests)
+	}
+}
+
+func TestAuthService_GetToken_DefaultExpiry(t *testing.T) {
+	// Tokens without an `exp` claim are valid for 23 hours
+	client, advance, authRequests := newClockTestClient(t, func(now time.Time) string {
+		return tokenResponse.Token
+	})
+
+	advance(time.Hour * 22)
+	if _, err := client.Auth.GetToken(context.Background()); err != nil {
+		t.Fatal("Error fetching Auth token\n", err)
+	}
+	if *authRequests != 1 {
+		t.Fatalf("Expected cached token to be used, got %d auth requests", *authRequests)
+	}
+
+	advance(time.Hour)
+	if _, err := client.Auth.GetToken(context.Background()); err != nil {
+		t.Fatal("Error fetching Auth token\n", err)
+	}
+	if *authRequests != 2 {
+		t.Fatalf("Expected token to be refreshed after 23 hours, got %d auth requests", *authRequests)
+	}
+}
+
+// Create a test client whose auth endpoint issues numbered tokens ("token-1", "token-2", ...).
+// All other requests are passed to the given handler.
+func newReauthTestClient(t *testing.T, handler http.HandlerFunc) *rize.Client {
+	t.Helper()
+
+	var authRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+internal.BasePath+"/auth" {
+			n := atomic.AddInt32(&authRequests, 1)
+			resp, _ := json.Marshal(&rize.AuthTokenResponse{Token: fmt.Sprintf("token-%d", n)})
+			w.Write(resp)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := rize.NewClient(&rize.Config{
+		ProgramUID: "program_uid",
+		HMACKey:    "hmac_key",
+		BaseURL:    server.URL,
+	})
+	if err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+
+	return client
+}
+
+func TestAuthService_ReplayOnUnauthorized(t *testing.T) {
+	var bodies []string
+	client := newReauthTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		// Reject the first token as if it had been revoked
+		if r.Header.Get("Authorization") == "token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errors":[],"status":401}`))
+			return
+		}
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	})
+
+	params := &rize.CustomerUpdateParams{
+		Email: rize.Some("olive.oyl@rizemoney.com"),
+	}
+	if _, err := client.Customers.Update(context.Background(), "EhrQZJNjCd79LLYq", params); err != nil {
+		t.Fatal("Expected request to succeed with a new token\n", err)
+	}
+
+	if token, _ := client.Auth.GetToken(context.Background()); token.Token != "token-2" {
+		t.Fatalf("Expected a new token to be cached, got %q", token.Token)
+	}
+	if len(bodies) != 2 || bodies[0] == "" || bodies[0] != bodies[1] {
+		t.Fatalf("Expected request body to be replayed once, got %q", bodies)
+	}
+}
+
+func TestAuthService_ReplayOnUnauthorized_Once(t *testing.T) {
+	var requests int
+	client := newReauthTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errors":[],"status":401}`))
+	})
+
+	_, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	if rerr, ok := err.(*rize.Error); !ok || rerr.Status != http.StatusUnauthorized {
+		t.Fatal("Expected a 401 Rize API error\n", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("Expected the request to be replayed exactly once, got %d requests", requests)
+	}
+}
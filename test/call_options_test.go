@@ -0,0 +1,106 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+func TestCallOptions_Headers(t *testing.T) {
+	var header http.Header
+	client := newTestClient(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	})
+
+	_, err := client.Customers.Get(context.Background(), customer.UID,
+		rize.WithHeader("X-Correlation-Id", "correlation-123"),
+		rize.WithRequestID("request-123"),
+	)
+	if err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+
+	if v := header.Get("X-Correlation-Id"); v != "correlation-123" {
+		t.Fatalf("Expected X-Correlation-Id header, received %q", v)
+	}
+	if v := header.Get("X-Request-Id"); v != "request-123" {
+		t.Fatalf("Expected X-Request-Id header, received %q", v)
+	}
+	if v := header.Get("Accept"); v != "application/json" {
+		t.Fatalf("Expected default Accept header, received %q", v)
+	}
+}
+
+func TestCallOptions_IdempotencyKey(t *testing.T) {
+	var key, externalUID string
+	client := newTestClient(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		var body rize.TransferCreateParams
+		json.NewDecoder(r.Body).Decode(&body)
+		key = r.Header.Get("Idempotency-Key")
+		externalUID = body.ExternalUID
+		resp, _ := json.Marshal(transfer)
+		w.Write(resp)
+	})
+
+	params := &rize.TransferCreateParams{
+		SourceSyntheticAccountUID:      "4XkJnsfHsuqrxmeX",
+		DestinationSyntheticAccountUID: "exMDShw6yM3NHLYV",
+		InitiatingCustomerUID:          "iDtmSA52zRhgN4iy",
+		USDTransferAmount:              rize.MustParseMoney("12.34"),
+	}
+	if _, err := client.Transfers.Create(context.Background(), params, rize.WithIdempotencyKey("key-123")); err != nil {
+		t.Fatal("Error creating Transfer\n", err)
+	}
+
+	if key != "key-123" {
+		t.Fatalf("Expected Idempotency-Key header %q, received %q", "key-123", key)
+	}
+	// A retried call with the same key must send the same ExternalUID
+	if externalUID != "key-123" {
+		t.Fatalf("Expected ExternalUID %q, received %q", "key-123", externalUID)
+	}
+}
+
+func TestCallOptions_Timeout(t *testing.T) {
+	client := newTestClient(t, &rize.Config{RetryPolicy: noRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	start := time.Now()
+	_, err := client.Customers.Get(context.Background(), customer.UID, rize.WithCallTimeout(time.Millisecond*50))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, received %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Millisecond*500 {
+		t.Fatalf("Expected the call to time out after 50ms, took %s", elapsed)
+	}
+}
+
+func TestCallOptions_Iterate(t *testing.T) {
+	var requestID string
+	client := newTestClient(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		requestID = r.Header.Get("X-Request-Id")
+		resp, _ := json.Marshal(&rize.CustomerListResponse{
+			ListResponse: rize.ListResponse{TotalCount: 1, Count: 1},
+			Data:         []*rize.Customer{customer},
+		})
+		w.Write(resp)
+	})
+
+	if _, err := client.Customers.ListAll(context.Background(), nil, nil, rize.WithRequestID("request-123")); err != nil {
+		t.Fatal("Error listing customers\n", err)
+	}
+	if requestID != "request-123" {
+		t.Fatalf("Expected X-Request-Id header, received %q", requestID)
+	}
+}
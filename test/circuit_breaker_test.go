@@ -0,0 +1,216 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+// Returns a clock for the client config and a function to move it forward
+func testClock() (func() time.Time, func(time.Duration)) {
+	var (
+		mu  sync.Mutex
+		now = time.Now()
+	)
+	clock := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+	return clock, advance
+}
+
+// Returns a handler that fails with 503 while `failing` is set, and counts the requests it receives
+func failingHandler(failing *atomic.Value, requests *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		if failing.Load().(bool) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	}
+}
+
+func TestCircuitBreaker_OpenAndRecover(t *testing.T) {
+	var (
+		failing  atomic.Value
+		requests int32
+	)
+	failing.Store(true)
+	clock, advance := testClock()
+
+	client := newTestClient(t, &rize.Config{
+		RetryPolicy:    noRetryPolicy,
+		Clock:          clock,
+		CircuitBreaker: &rize.CircuitBreaker{FailureThreshold: 3, CoolDown: time.Minute},
+	}, failingHandler(&failing, &requests))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); !errors.Is(err, rize.ErrServer) {
+			t.Fatalf("Expected ErrServer, received %v", err)
+		}
+	}
+	if state := client.CircuitState("customers"); state != rize.CircuitOpen {
+		t.Fatalf("Expected open circuit, received %s", state)
+	}
+
+	// Requests fail fast without reaching the API
+	_, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	if !errors.Is(err, rize.ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen, received %v", err)
+	}
+	var openErr *rize.CircuitOpenError
+	if !errors.As(err, &openErr) || openErr.Group != "customers" {
+		t.Fatalf("Expected CircuitOpenError for customers, received %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 3 {
+		t.Fatalf("Expected 3 requests, received %d", n)
+	}
+
+	// A trial request is allowed after the cool-down and closes the circuit
+	advance(time.Minute)
+	if state := client.CircuitState("customers"); state != rize.CircuitHalfOpen {
+		t.Fatalf("Expected half-open circuit, received %s", state)
+	}
+	failing.Store(false)
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+	if state := client.CircuitState("customers"); state != rize.CircuitClosed {
+		t.Fatalf("Expected closed circuit, received %s", state)
+	}
+}
+
+func TestCircuitBreaker_FailedTrialReopens(t *testing.T) {
+	var (
+		failing  atomic.Value
+		requests int32
+	)
+	failing.Store(true)
+	clock, advance := testClock()
+
+	client := newTestClient(t, &rize.Config{
+		RetryPolicy:    noRetryPolicy,
+		Clock:          clock,
+		CircuitBreaker: &rize.CircuitBreaker{FailureThreshold: 1, CoolDown: time.Minute},
+	}, failingHandler(&failing, &requests))
+
+	client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	advance(time.Minute)
+
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); !errors.Is(err, rize.ErrServer) {
+		t.Fatalf("Expected ErrServer, received %v", err)
+	}
+	if state := client.CircuitState("customers"); state != rize.CircuitOpen {
+		t.Fatalf("Expected open circuit, received %s", state)
+	}
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); !errors.Is(err, rize.ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen, received %v", err)
+	}
+}
+
+func TestCircuitBreaker_Groups(t *testing.T) {
+	var (
+		failing  atomic.Value
+		requests int32
+	)
+	failing.Store(true)
+	clock, _ := testClock()
+
+	client := newTestClient(t, &rize.Config{
+		RetryPolicy: noRetryPolicy,
+		Clock:       clock,
+		CircuitBreaker: &rize.CircuitBreaker{
+			FailureThreshold: 5,
+			Groups: map[string]*rize.CircuitBreaker{
+				"customers": {FailureThreshold: 1},
+			},
+		},
+	}, failingHandler(&failing, &requests))
+
+	client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	client.Transfers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+
+	states := client.CircuitStates()
+	if states["customers"] != rize.CircuitOpen {
+		t.Fatalf("Expected open customers circuit, received %s", states["customers"])
+	}
+	if states["transfers"] != rize.CircuitClosed {
+		t.Fatalf("Expected closed transfers circuit, received %s", states["transfers"])
+	}
+}
+
+func TestCircuitBreaker_Disabled(t *testing.T) {
+	var (
+		failing  atomic.Value
+		requests int32
+	)
+	failing.Store(true)
+
+	client := newTestClient(t, &rize.Config{RetryPolicy: noRetryPolicy}, failingHandler(&failing, &requests))
+
+	for i := 0; i < 10; i++ {
+		client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	}
+	if n := atomic.LoadInt32(&requests); n != 10 {
+		t.Fatalf("Expected 10 requests, received %d", n)
+	}
+	if states := client.CircuitStates(); len(states) != 0 {
+		t.Fatalf("Expected no circuits, received %v", states)
+	}
+}
+
+func TestCircuitBreaker_NotSent(t *testing.T) {
+	var (
+		failing  atomic.Value
+		requests int32
+	)
+	failing.Store(false)
+
+	// The first request uses up the only token, so the next request cannot be sent before its deadline
+	client := newTestClient(t, &rize.Config{
+		RetryPolicy:    noRetryPolicy,
+		CircuitBreaker: &rize.CircuitBreaker{FailureThreshold: 1},
+		RateLimit:      &rize.RateLimit{RequestsPerSecond: 0.1},
+	}, failingHandler(&failing, &requests))
+	client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := client.Customers.Get(ctx, "EhrQZJNjCd79LLYq"); !errors.Is(err, rize.ErrRateLimitWait) {
+		t.Fatal("Expected the rate limiter to reject the request\n", err)
+	}
+
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("Expected only the first request to be sent, received %d", n)
+	}
+	if state := client.CircuitState("customers"); state != rize.CircuitClosed {
+		t.Fatalf("Expected closed circuit, received %s", state)
+	}
+
+	// Requests that fail before being sent, such as an invalid path, are not recorded either
+	client = newTestClient(t, &rize.Config{
+		RetryPolicy:    noRetryPolicy,
+		CircuitBreaker: &rize.CircuitBreaker{FailureThreshold: 1},
+	}, failingHandler(&failing, &requests))
+	if _, err := client.Do(context.Background(), http.MethodGet, "customers/foo%zz", nil, nil, nil); err == nil {
+		t.Fatal("Expected an invalid path to be rejected")
+	}
+	if state := client.CircuitState("customers"); state != rize.CircuitClosed {
+		t.Fatalf("Expected closed circuit, received %s", state)
+	}
+}
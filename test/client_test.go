@@ -30,7 +30,7 @@ var (
 		Title:      "Path/Method not found",
 		OccurredAt: time.Now(),
 	}
-	errors = append([]*rize.ErrorDetails{}, errDetails)
+	errList = append([]*rize.ErrorDetails{}, errDetails)
 )
 
 // TestMain is the test runner init
@@ -364,12 +364,43 @@ func mockHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	default:
 		errDetails.Detail = fmt.Sprintf("Error in path %s, method %s", path, r.Method)
-		resp, _ := json.Marshal(&rize.Error{Errors: errors, Status: http.StatusNotFound})
+		resp, _ := json.Marshal(&rize.Error{Errors: errList, Status: http.StatusNotFound})
 		w.WriteHeader(http.StatusNotFound)
 		w.Write(resp)
 	}
 }
 
+// Create a Rize client backed by its own mock server. Auth requests are handled automatically and
+// all other requests are passed to the given handler.
+func newTestClient(t *testing.T, config *rize.Config, handler http.HandlerFunc) *rize.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+internal.BasePath+"/auth" {
+			resp, _ := json.Marshal(tokenResponse)
+			w.Write(resp)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	if config == nil {
+		config = &rize.Config{}
+	}
+	config.ProgramUID = "program_uid"
+	config.HMACKey = "hmac_key"
+	config.Environment = "sandbox"
+	config.BaseURL = server.URL
+
+	client, err := rize.NewClient(config)
+	if err != nil {
+		t.Fatal("Error creating test client\n", err)
+	}
+
+	return client
+}
+
 // Validate SDK requests and responses against the latest OpenAPI spec file for the Rize Platform. Also searches
 // for any missing req/resp fields between the SDK and OpenAPI spec.
 func validateSchema(method string, path string, status int, queryParams interface{}, bodyParams interface{}, resp interface{}) error {
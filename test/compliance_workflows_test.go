@@ -14,10 +14,10 @@ var workflow = &rize.Workflow{
 	UID: "SPbiwv93C6M5pSWu",
 	Summary: &rize.WorkflowSummary{
 		AcceptedQuantity: 1,
-		BegunAt:          time.Now(),
+		BegunAt:          rize.NewNullTime(time.Now()),
 		CompletedStep:    1,
 		CurrentStep:      1,
-		Status:           "in_progress",
+		Status:           rize.WorkflowStatusInProgress,
 	},
 	Customer: &rize.WorkflowCustomer{
 		Email:       "tomas@example.com",
@@ -34,7 +34,7 @@ var workflow = &rize.Workflow{
 		Step:                        1,
 		Version:                     1,
 		UID:                         "vU7f8jrLCww7ev2H",
-		AcceptedAt:                  time.Now(),
+		AcceptedAt:                  rize.NewNullTime(time.Now()),
 	}},
 	CurrentStepDocumentsPending: []*rize.WorkflowPendingDocument{{
 		ElectronicSignatureRequired: "no",
@@ -59,7 +59,7 @@ func TestComplianceWorkflowService_List(t *testing.T) {
 	params := &rize.WorkflowListParams{
 		CustomerUID: "S62MaHx6WwsqG9vQ",
 		ProductUID:  "pQtTCSXz57fuefzp",
-		InProgress:  true,
+		InProgress:  rize.Some(true),
 		Limit:       100,
 		Offset:      10,
 	}
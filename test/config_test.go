@@ -0,0 +1,224 @@
+package rize_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rizefinance/rize-go-sdk/config"
+)
+
+const profileYAML = `
+default: sandbox
+profiles:
+  sandbox:
+    program_uid: sandbox_program
+    hmac_key: sandbox_hmac
+    environment: sandbox
+    mq_username: sandbox_user
+    mq_password: sandbox_password
+    mq_client_id: sandbox_client
+  production:
+    program_uid: production_program
+    environment: production
+    hmac_key_secret: rize/production/hmac
+`
+
+const profileJSON = `{
+	"profiles": {
+		"integration": {"program_uid": "integration_program", "hmac_key": "integration_hmac", "environment": "integration"}
+	}
+}`
+
+// Write a profile file to a temporary directory
+func writeProfileFile(t *testing.T, name string, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal("Error writing profile file\n", err)
+	}
+	return path
+}
+
+// Returns a LookupEnv function backed by the given variables
+func lookupEnv(vars map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := vars[key]
+		return value, ok
+	}
+}
+
+func TestConfig_LoadDefaultProfile(t *testing.T) {
+	path := writeProfileFile(t, "rize.yaml", profileYAML)
+
+	profile, err := config.Load(context.Background(), &config.Options{File: path, LookupEnv: lookupEnv(nil)})
+	if err != nil {
+		t.Fatal("Error loading profile\n", err)
+	}
+
+	cfg, err := profile.RizeConfig()
+	if err != nil {
+		t.Fatal("Error building rize.Config\n", err)
+	}
+	if cfg.ProgramUID != "sandbox_program" || cfg.HMACKey != "sandbox_hmac" || cfg.Environment != "sandbox" {
+		t.Fatalf("Unexpected rize.Config %+v", cfg)
+	}
+
+	mqCfg, err := profile.MQConfig()
+	if err != nil {
+		t.Fatal("Error building mq.Config\n", err)
+	}
+	if mqCfg.Username != "sandbox_user" || mqCfg.Password != "sandbox_password" || mqCfg.ClientID != "sandbox_client" {
+		t.Fatalf("Unexpected mq.Config %+v", mqCfg)
+	}
+}
+
+func TestConfig_LoadJSON(t *testing.T) {
+	path := writeProfileFile(t, "rize.json", profileJSON)
+
+	profile, err := config.Load(context.Background(), &config.Options{File: path, LookupEnv: lookupEnv(nil)})
+	if err != nil {
+		t.Fatal("Error loading profile\n", err)
+	}
+	if profile.Name != "integration" || profile.ProgramUID != "integration_program" {
+		t.Fatalf("Unexpected profile %+v", profile)
+	}
+}
+
+func TestConfig_EnvOverridesFile(t *testing.T) {
+	path := writeProfileFile(t, "rize.yaml", profileYAML)
+
+	profile, err := config.Load(context.Background(), &config.Options{LookupEnv: lookupEnv(map[string]string{
+		"RIZE_CONFIG_FILE": path,
+		"rize_profile":     "sandbox",
+		"PROGRAM_UID":      "env_program",
+	})})
+	if err != nil {
+		t.Fatal("Error loading profile\n", err)
+	}
+	if profile.ProgramUID != "env_program" || profile.HMACKey != "sandbox_hmac" {
+		t.Fatalf("Unexpected profile %+v", profile)
+	}
+}
+
+func TestConfig_SecretProvider(t *testing.T) {
+	path := writeProfileFile(t, "rize.yaml", profileYAML)
+
+	var requested string
+	secrets := config.SecretProviderFunc(func(ctx context.Context, name string) (string, error) {
+		requested = name
+		return "secret_hmac", nil
+	})
+
+	profile, err := config.Load(context.Background(), &config.Options{
+		File:      path,
+		Profile:   "production",
+		Secrets:   secrets,
+		LookupEnv: lookupEnv(nil),
+	})
+	if err != nil {
+		t.Fatal("Error loading profile\n", err)
+	}
+	if requested != "rize/production/hmac" || profile.HMACKey != "secret_hmac" {
+		t.Fatalf("Expected HMAC key from secret %q, received %q", requested, profile.HMACKey)
+	}
+
+	// Secrets are masked when the profile is printed
+	if s := fmt.Sprintf("%+v", profile); strings.Contains(s, "secret_hmac") {
+		t.Fatalf("Expected HMAC key to be redacted, received %s", s)
+	}
+}
+
+func TestConfig_SecretProviderMQOnly(t *testing.T) {
+	path := writeProfileFile(t, "rize.yaml", `
+profiles:
+  events:
+    environment: sandbox
+    mq_username: events_user
+    mq_client_id: events_client
+`)
+
+	var requested []string
+	secrets := config.SecretProviderFunc(func(ctx context.Context, name string) (string, error) {
+		requested = append(requested, name)
+		return "secret_password", nil
+	})
+
+	// Only the secrets the profile uses are fetched
+	profile, err := config.Load(context.Background(), &config.Options{File: path, Secrets: secrets, LookupEnv: lookupEnv(nil)})
+	if err != nil {
+		t.Fatal("Error loading profile\n", err)
+	}
+	if len(requested) != 1 || requested[0] != config.SecretMQPassword || profile.HMACKey != "" {
+		t.Fatalf("Expected only the MQ password to be fetched, requested %q", requested)
+	}
+	if _, err := profile.MQConfig(); err != nil {
+		t.Fatal("Error building MQ config\n", err)
+	}
+}
+
+func TestConfig_EnvPrecedence(t *testing.T) {
+	path := writeProfileFile(t, "rize.yaml", profileYAML)
+
+	profile, err := config.Load(context.Background(), &config.Options{File: path, LookupEnv: lookupEnv(map[string]string{
+		// An empty variable falls back to the upper case variant
+		"program_uid": "",
+		"PROGRAM_UID": "env_program",
+		// The lower case variable takes precedence
+		"environment": "integration",
+		"ENVIRONMENT": "production",
+		// Empty variables do not override the file
+		"hmac_key": "",
+		"DEBUG":    "true",
+	})})
+	if err != nil {
+		t.Fatal("Error loading profile\n", err)
+	}
+	if profile.ProgramUID != "env_program" || profile.Environment != "integration" || profile.HMACKey != "sandbox_hmac" || !profile.Debug {
+		t.Fatalf("Unexpected profile %+v", profile)
+	}
+
+	_, err = config.Load(context.Background(), &config.Options{File: path, LookupEnv: lookupEnv(map[string]string{"debug": "maybe"})})
+	if err == nil {
+		t.Fatal("Expected an invalid debug value to be rejected")
+	}
+}
+
+func TestConfig_Errors(t *testing.T) {
+	path := writeProfileFile(t, "rize.yaml", profileYAML)
+	failing := config.SecretProviderFunc(func(ctx context.Context, name string) (string, error) {
+		return "", errors.New("vault unavailable")
+	})
+
+	tests := []struct {
+		name string
+		opts *config.Options
+	}{
+		{"missing file", &config.Options{File: filepath.Join(t.TempDir(), "missing.yaml")}},
+		{"missing profile", &config.Options{File: path, Profile: "staging"}},
+		{"invalid file", &config.Options{File: writeProfileFile(t, "bad.yaml", "profiles: [")}},
+		{"secret error", &config.Options{File: path, Profile: "production", Secrets: failing}},
+	}
+	for _, tt := range tests {
+		tt.opts.LookupEnv = lookupEnv(nil)
+		if _, err := config.Load(context.Background(), tt.opts); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+
+	// Missing required values are reported instead of exiting
+	profile, err := config.Load(context.Background(), &config.Options{LookupEnv: lookupEnv(nil)})
+	if err != nil {
+		t.Fatal("Error loading profile\n", err)
+	}
+	if _, err := profile.RizeConfig(); err == nil || !strings.Contains(err.Error(), "hmac_key, program_uid") {
+		t.Fatalf("Expected missing values error, received %v", err)
+	}
+	if _, err := profile.MQConfig(); err == nil {
+		t.Fatal("Expected missing values error")
+	}
+}
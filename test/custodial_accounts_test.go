@@ -25,20 +25,20 @@ var custodialAccount = &rize.CustodialAccount{
 		ErrorName:        "DOB does not match",
 		ErrorDescription: "The given DOB does not match the known DOB for the SSN provided",
 	}},
-	NetUSDBalance:          "12.34",
-	NetUSDPendingBalance:   "-2.56",
-	NetUSDAvailableBalance: "9.78",
+	NetUSDBalance:          rize.MustParseMoney("12.34"),
+	NetUSDPendingBalance:   rize.MustParseMoney("-2.56"),
+	NetUSDAvailableBalance: rize.MustParseMoney("9.78"),
 	AssetBalances: []*rize.CustodialAccountAssetBalance{{
 		AssetQuantity:   "122.11",
 		AssetType:       "USD",
-		CurrentUSDValue: "122.11",
+		CurrentUSDValue: rize.MustParseMoney("122.11"),
 		Debit:           true,
 	}},
 	AccountNumber:       "123456789012",
 	AccountNumberMasked: "9012",
 	RoutingNumber:       "123456789",
-	OpenedAt:            time.Now(),
-	ClosedAt:            time.Now(),
+	OpenedAt:            rize.NewNullTime(time.Now()),
+	ClosedAt:            rize.NewNullTime(time.Now()),
 }
 
 func TestCustodialAccountService_List(t *testing.T) {
@@ -47,7 +47,7 @@ func TestCustodialAccountService_List(t *testing.T) {
 		ExternalUID: "client-generated-id",
 		Limit:       100,
 		Offset:      10,
-		Liability:   true,
+		Liability:   rize.Some(true),
 		Type:        "dda",
 	}
 	resp, err := rc.CustodialAccounts.List(context.Background(), params)
@@ -8,27 +8,26 @@ import (
 	"time"
 
 	"github.com/rizefinance/rize-go-sdk"
-	"github.com/rizefinance/rize-go-sdk/internal"
 )
 
 // Complete Customer{} response data
 var customer = &rize.Customer{
 	UID:                "y9reyPMNEWuuYSC1",
 	ExternalUID:        "partner-generated-id",
-	ActivatedAt:        time.Now(),
+	ActivatedAt:        rize.NewNullTime(time.Now()),
 	CreatedAt:          time.Now(),
-	CustomerType:       "primary",
+	CustomerType:       rize.CustomerTypePrimary,
 	Email:              "olive.oyl@rizemoney.com",
-	KYCStatus:          "manual_review",
+	KYCStatus:          rize.KYCStatusManualReview,
 	KYCStatusReasons:   []string{"Approved"},
 	LockReason:         "other",
-	LockedAt:           time.Now(),
-	PIIConfirmedAt:     time.Now(),
+	LockedAt:           rize.NewNullTime(time.Now()),
+	PIIConfirmedAt:     rize.NewNullTime(time.Now()),
 	PoolUIDs:           []string{"HiuQZJNjCd79LLYq", "NoPJB9g9ZQTh5qMv"},
 	PrimaryCustomerUID: "EhrQZJNjCd79LLYq",
 	ProfileResponses: []*rize.CustomerProfileResponse{{
 		ProfileRequirement: "Please provide your approximate annual income in USD.",
-		ProfileResponse: &internal.CustomerProfileResponseItem{
+		ProfileResponse: &rize.ProfileResponse{
 			Num0: "string",
 			Num1: "string",
 			Num2: "string",
@@ -37,8 +36,8 @@ var customer = &rize.Customer{
 	}},
 	ProgramUID:            "kaxHFJnWvJxRJZxr",
 	SecondaryCustomerUIDs: []string{"464QyebpxbBNrGkX"},
-	Status:                "initiated",
-	TotalBalance:          "12345.67",
+	Status:                rize.CustomerStatusInitiated,
+	TotalBalance:          rize.MustParseMoney("12345.67"),
 	Details: &rize.CustomerDetails{
 		FirstName:    "Olive",
 		MiddleName:   "Olivia",
@@ -46,7 +45,7 @@ var customer = &rize.Customer{
 		Suffix:       "Jr.",
 		Phone:        "5555551212",
 		BusinessName: "Oliver's Olive Emporium",
-		DOB:          internal.DOB(time.Now()),
+		DOB:          rize.Date(time.Now()),
 		SSNLastFour:  "3333",
 		Address: &rize.CustomerAddress{
 			Street1:    "123 Abc St.",
@@ -61,14 +60,14 @@ var customer = &rize.Customer{
 func TestCustomerService_List(t *testing.T) {
 	params := &rize.CustomerListParams{
 		UID:              "uKxmLxUEiSj5h4M3",
-		Status:           "identity_verified",
-		IncludeInitiated: true,
-		KYCStatus:        "denied",
-		CustomerType:     "primary",
+		Status:           rize.CustomerStatusIdentityVerified,
+		IncludeInitiated: rize.Some(true),
+		KYCStatus:        rize.KYCStatusDenied,
+		CustomerType:     rize.CustomerTypePrimary,
 		FirstName:        "Olive",
 		LastName:         "Oyl",
 		Email:            "olive.oyl@popeyes.com",
-		Locked:           false,
+		Locked:           rize.Some(false),
 		ProgramUID:       "pQtTCSXz57fuefzp",
 		BusinessName:     "Business inc",
 		ExternalUID:      "client-generated-id",
@@ -90,7 +89,7 @@ func TestCustomerService_List(t *testing.T) {
 
 func TestCustomerService_Create(t *testing.T) {
 	params := &rize.CustomerCreateParams{
-		CustomerType:       "primary",
+		CustomerType:       rize.CustomerTypePrimary,
 		PrimaryCustomerUID: "kbF5TGrmwGizQuzZ",
 		ExternalUID:        "client-generated-id",
 		Email:              "olive.oyl@popeyes.com",
@@ -102,7 +101,7 @@ func TestCustomerService_Create(t *testing.T) {
 			Phone:        "5555551212",
 			BusinessName: "Oliver's Olive Emporium",
 			SSN:          "111-22-3333",
-			DOB:          internal.DOB(time.Now()),
+			DOB:          rize.Date(time.Now()),
 			Address: &rize.CustomerAddress{
 				Street1:    "123 Abc St.",
 				Street2:    "Apt 2",
@@ -125,7 +124,7 @@ func TestCustomerService_Create(t *testing.T) {
 
 func TestCustomerService_Create_SecondaryMissingPrimaryCustomerUID(t *testing.T) {
 	params := &rize.CustomerCreateParams{
-		CustomerType: "secondary",
+		CustomerType: rize.CustomerTypeSecondary,
 	}
 	resp, err := rc.Customers.Create(context.Background(), params)
 
@@ -150,8 +149,8 @@ func TestCustomerService_Get(t *testing.T) {
 
 func TestCustomerService_Update(t *testing.T) {
 	params := &rize.CustomerUpdateParams{
-		Email:       "olive.oyl@rizemoney.com",
-		ExternalUID: "client-generated-id",
+		Email:       rize.Some("olive.oyl@rizemoney.com"),
+		ExternalUID: rize.Some("client-generated-id"),
 		Details: &rize.CustomerDetails{
 			FirstName:    "Olive",
 			MiddleName:   "Olivia",
@@ -159,7 +158,7 @@ func TestCustomerService_Update(t *testing.T) {
 			Suffix:       "Jr.",
 			Phone:        "5555551212",
 			BusinessName: "Oliver's Olive Emporium",
-			DOB:          internal.DOB(time.Now()),
+			DOB:          rize.Date(time.Now()),
 			SSN:          "111-22-3333",
 			SSNLastFour:  "3333",
 			Address: &rize.CustomerAddress{
@@ -244,7 +243,7 @@ func TestCustomerService_UpdateProfileResponses(t *testing.T) {
 	// Update Profile Response with string response
 	params := &rize.CustomerProfileResponseParams{
 		ProfileRequirementUID: "ptRLF7nQvy8VoqM1",
-		ProfileResponse: &internal.CustomerProfileResponseItem{
+		ProfileResponse: &rize.ProfileResponse{
 			Response: "Response string",
 		},
 	}
@@ -261,7 +260,7 @@ func TestCustomerService_UpdateProfileResponses(t *testing.T) {
 	// Update Profile Response with ordered list response
 	paramList := &rize.CustomerProfileResponseParams{
 		ProfileRequirementUID: "ptRLF7nQvy8VoqM1",
-		ProfileResponse: &internal.CustomerProfileResponseItem{
+		ProfileResponse: &rize.ProfileResponse{
 			Num0: "string",
 			Num1: "string",
 			Num2: "string",
@@ -20,12 +20,12 @@ var debitCard = &rize.DebitCard{
 	CardLastFourDigits:  "9012",
 	CardArtworkUID:      "EhrQZJNjCd79LLYq",
 	IssuedOn:            "2019-10-21",
-	Status:              "normal",
-	Type:                "physical",
+	Status:              rize.DebitCardStatusNormal,
+	Type:                rize.DebitCardTypePhysical,
 	ReadyToUse:          true,
-	LockReason:          "lock reason",
-	LockedAt:            time.Now(),
-	ClosedAt:            time.Now(),
+	LockReason:          rize.DebitCardLockReasonOther,
+	LockedAt:            rize.NewNullTime(time.Now()),
+	ClosedAt:            rize.NewNullTime(time.Now()),
 	LatestShippingAddress: &rize.DebitCardShippingAddress{
 		Street1:    "123 Abc St.",
 		Street2:    "Apt 2",
@@ -51,8 +51,8 @@ func TestDebitCardService_List(t *testing.T) {
 		Limit:       100,
 		Offset:      10,
 		PoolUID:     "wTSMX1GubP21ev2h",
-		Locked:      true,
-		Status:      "queued",
+		Locked:      rize.Some(true),
+		Status:      rize.DebitCardStatusQueued,
 	}
 
 	resp, err := rc.DebitCards.List(context.Background(), params)
@@ -118,7 +118,7 @@ func TestDebitCardService_Activate(t *testing.T) {
 
 func TestDebitCardService_Lock(t *testing.T) {
 	params := &rize.DebitCardLockParams{
-		LockReason: "Fraud detected",
+		LockReason: rize.DebitCardLockReasonFraud,
 	}
 	resp, err := rc.DebitCards.Lock(context.Background(), "Lt6qjTNnYLjFfEWL", params)
 	if err != nil {
@@ -144,7 +144,7 @@ func TestDebitCardService_Unlock(t *testing.T) {
 func TestDebitCardService_Reissue(t *testing.T) {
 	params := &rize.DebitCardReissueParams{
 		CardArtworkUID: "EhrQZJNjCd79LLYq",
-		ReissueReason:  "damaged",
+		ReissueReason:  rize.DebitCardReissueReasonDamaged,
 		ShippingAddress: &rize.DebitCardShippingAddress{
 			Street1:    "123 Abc St",
 			Street2:    "Apt 2",
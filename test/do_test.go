@@ -0,0 +1,110 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+func TestClientDo(t *testing.T) {
+	var (
+		req  *http.Request
+		body map[string]string
+	)
+	client := newTestClient(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		b, _ := io.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		w.Header().Set("X-Request-Id", "request-123")
+		w.Write([]byte(`{"uid":"new123","status":"active"}`))
+	})
+
+	var out struct {
+		UID    string `json:"uid"`
+		Status string `json:"status"`
+	}
+	resp, err := client.Do(context.Background(), http.MethodPost, "/new_endpoint/abc", url.Values{"filter": {"x"}}, map[string]string{"name": "test"}, &out)
+	if err != nil {
+		t.Fatal("Error calling endpoint\n", err)
+	}
+
+	if req.Method != http.MethodPost || req.URL.Path != "/api/v1/new_endpoint/abc" || req.URL.Query().Get("filter") != "x" {
+		t.Fatalf("Unexpected request %s %s", req.Method, req.URL)
+	}
+	if req.Header.Get("Authorization") == "" {
+		t.Fatal("Expected Authorization header")
+	}
+	if body["name"] != "test" {
+		t.Fatalf("Expected JSON body, received %v", body)
+	}
+	if out.UID != "new123" || out.Status != "active" {
+		t.Fatalf("Expected decoded response, received %+v", out)
+	}
+	if resp.StatusCode != http.StatusOK || resp.RequestID != "request-123" {
+		t.Fatalf("Expected response metadata, received %d %q", resp.StatusCode, resp.RequestID)
+	}
+}
+
+func TestClientDo_Error(t *testing.T) {
+	client := newTestClient(t, &rize.Config{RetryPolicy: noRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"errors":[{"code":409,"title":"Conflict"}],"status":409}`))
+	})
+
+	_, err := client.Do(context.Background(), http.MethodPut, "new_endpoint", nil, nil, nil)
+	if !errors.Is(err, rize.ErrConflict) {
+		t.Fatalf("Expected ErrConflict, received %v", err)
+	}
+}
+
+func TestClientDo_Middleware(t *testing.T) {
+	var op *rize.Operation
+	client := newTestClient(t, &rize.Config{
+		Middleware: []rize.Middleware{func(next rize.Handler) rize.Handler {
+			return func(ctx context.Context, req *rize.Request) (*rize.Response, error) {
+				op = req.Operation
+				return next(ctx, req)
+			}
+		}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var out map[string]interface{}
+	if _, err := client.Do(context.Background(), http.MethodDelete, "new_endpoint/abc", nil, nil, &out); err != nil {
+		t.Fatal("Error calling endpoint\n", err)
+	}
+	if op.Name != "Client.Do" || op.Group() != "new_endpoint" || op.Path != "new_endpoint/abc" || op.PathTemplate != "new_endpoint/{path}" {
+		t.Fatalf("Unexpected operation %+v", op)
+	}
+}
+
+func TestClientDo_QueryInPath(t *testing.T) {
+	var req *http.Request
+	client := newTestClient(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		w.Write([]byte(`{}`))
+	})
+
+	query := url.Values{"limit": {"10"}}
+	if _, err := client.Do(context.Background(), http.MethodGet, "new_endpoint?status=active&limit=20", query, nil, nil); err != nil {
+		t.Fatal("Error calling endpoint\n", err)
+	}
+
+	if req.URL.Path != "/api/v1/new_endpoint" || req.URL.Query().Get("status") != "active" || len(req.URL.Query()["limit"]) != 2 {
+		t.Fatalf("Expected the query string to be merged, received %s", req.URL)
+	}
+	if len(query) != 1 {
+		t.Fatalf("Expected the caller's query to be unchanged, received %v", query)
+	}
+
+	if _, err := client.Do(context.Background(), http.MethodGet, "new_endpoint?status=%zz", nil, nil, nil); err == nil {
+		t.Fatal("Expected an invalid query string to be rejected")
+	}
+}
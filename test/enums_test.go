@@ -0,0 +1,64 @@
+package rize_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+func TestEnums_IsValid(t *testing.T) {
+	if !rize.CustomerStatusActive.IsValid() || !rize.KYCStatusUnderReview.IsValid() || !rize.TransferStatusSettled.IsValid() {
+		t.Fatal("Expected known values to be valid")
+	}
+	if rize.CustomerStatus("actve").IsValid() || rize.DebitCardStatus("").IsValid() {
+		t.Fatal("Expected unknown values to be invalid")
+	}
+	if !rize.LineItemStatusInProgress.IsValid() || !rize.CustodialAccountTypeForBenefitOf.IsValid() || rize.PinwheelJobStatus("done").IsValid() {
+		t.Fatal("Expected only known resource values to be valid")
+	}
+	if rize.TransactionTypeCardRefund.String() != "card_refund" {
+		t.Fatalf("Unexpected String() value %q", rize.TransactionTypeCardRefund)
+	}
+}
+
+func TestEnums_UnmarshalUnknown(t *testing.T) {
+	var c rize.Customer
+	if err := json.Unmarshal([]byte(`{"status":"frozen","kyc_status":"approved","customer_type":null}`), &c); err != nil {
+		t.Fatal("Error decoding customer\n", err)
+	}
+
+	// Values added to the API later are preserved rather than rejected
+	if c.Status != "frozen" || c.Status.IsValid() {
+		t.Fatalf("Expected unknown status to be preserved, received %q", c.Status)
+	}
+	if c.KYCStatus != rize.KYCStatusApproved || c.CustomerType != "" {
+		t.Fatalf("Unexpected customer %+v", c)
+	}
+
+	out, _ := json.Marshal(&c)
+	var fields map[string]interface{}
+	json.Unmarshal(out, &fields)
+	if fields["status"] != "frozen" {
+		t.Fatalf("Expected unknown status to be re-encoded, received %s", out)
+	}
+}
+
+func TestEnums_QueryParams(t *testing.T) {
+	v, err := query.Values(&rize.TransactionListParams{
+		Type:   rize.TransactionTypeATMWithdrawal,
+		Status: rize.TransactionStatusSettled,
+	})
+	if err != nil {
+		t.Fatal("Error encoding params\n", err)
+	}
+	if v.Get("type") != "atm_withdrawal" || v.Get("status") != "settled" {
+		t.Fatalf("Unexpected query %s", v.Encode())
+	}
+
+	v, _ = query.Values(&rize.SyntheticLineItemListParams{Status: rize.LineItemStatusInProgress})
+	if v.Get("status") != "in_progress" {
+		t.Fatalf("Unexpected query %s", v.Encode())
+	}
+}
@@ -0,0 +1,94 @@
+package rize_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+// Disable retries so error responses are returned immediately
+var noRetryPolicy = &rize.RetryPolicy{MaxAttempts: 1}
+
+func TestError_Sentinels(t *testing.T) {
+	cases := []struct {
+		status    int
+		sentinel  error
+		retryable bool
+	}{
+		{http.StatusBadRequest, rize.ErrValidation, false},
+		{http.StatusUnprocessableEntity, rize.ErrValidation, false},
+		{http.StatusForbidden, rize.ErrUnauthorized, false},
+		{http.StatusNotFound, rize.ErrNotFound, false},
+		{http.StatusConflict, rize.ErrConflict, false},
+		{http.StatusTooManyRequests, rize.ErrRateLimited, true},
+		{http.StatusInternalServerError, rize.ErrServer, false},
+		{http.StatusServiceUnavailable, rize.ErrServer, true},
+	}
+
+	for _, c := range cases {
+		status := c.status
+		client := newTestClient(t, &rize.Config{RetryPolicy: noRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"errors":[{"code":1,"title":"Error title"}],"status":0}`))
+		})
+
+		_, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+		if !errors.Is(err, c.sentinel) {
+			t.Fatalf("Expected status %d to match %q, got %v", c.status, c.sentinel, err)
+		}
+
+		var rerr *rize.Error
+		if !errors.As(err, &rerr) {
+			t.Fatalf("Expected a Rize API error, got %v", err)
+		}
+		if rerr.Status != c.status || rerr.Retryable() != c.retryable {
+			t.Fatalf("Expected status %d (retryable %t), got %d (retryable %t)", c.status, c.retryable, rerr.Status, rerr.Retryable())
+		}
+		if len(rerr.Errors) != 1 || rerr.Errors[0].Title != "Error title" {
+			t.Fatalf("Expected error details to be parsed, got %+v", rerr.Errors)
+		}
+	}
+}
+
+func TestError_UnparseableBody(t *testing.T) {
+	client := newTestClient(t, &rize.Config{RetryPolicy: noRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1234")
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html><body>502 Bad Gateway</body></html>"))
+	})
+
+	_, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+
+	var rerr *rize.Error
+	if !errors.As(err, &rerr) {
+		t.Fatal("Expected a Rize API error\n", err)
+	}
+	if !errors.Is(err, rize.ErrServer) || !rerr.Retryable() {
+		t.Fatal("Expected a retryable server error\n", err)
+	}
+	if rerr.Status != http.StatusBadGateway ||
+		rerr.Method != http.MethodGet ||
+		!strings.HasSuffix(rerr.Path, "/customers/EhrQZJNjCd79LLYq") ||
+		rerr.RequestID != "req-1234" ||
+		!strings.Contains(string(rerr.Body), "502 Bad Gateway") {
+		t.Fatalf("Expected error to describe the failed request, got %+v", rerr)
+	}
+	if !strings.Contains(err.Error(), "502 Bad Gateway") {
+		t.Fatalf("Expected error message to include the response body, got %q", err.Error())
+	}
+}
+
+func TestError_EmptyBody(t *testing.T) {
+	client := newTestClient(t, &rize.Config{RetryPolicy: noRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	_, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	if !errors.Is(err, rize.ErrConflict) {
+		t.Fatal("Expected a conflict error\n", err)
+	}
+}
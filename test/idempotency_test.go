@@ -0,0 +1,204 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+func TestIdempotency_GeneratesKey(t *testing.T) {
+	var keys, externalUIDs []string
+	client := newTestClient(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		var body rize.TransferCreateParams
+		json.NewDecoder(r.Body).Decode(&body)
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		externalUIDs = append(externalUIDs, body.ExternalUID)
+		resp, _ := json.Marshal(transfer)
+		w.Write(resp)
+	})
+
+	params := &rize.TransferCreateParams{
+		SourceSyntheticAccountUID:      "4XkJnsfHsuqrxmeX",
+		DestinationSyntheticAccountUID: "exMDShw6yM3NHLYV",
+		InitiatingCustomerUID:          "iDtmSA52zRhgN4iy",
+		USDTransferAmount:              rize.MustParseMoney("12.34"),
+	}
+	// Reusing the params for a second transfer must not reuse the first key
+	for i := 0; i < 2; i++ {
+		if _, err := client.Transfers.Create(context.Background(), params); err != nil {
+			t.Fatal("Error creating Transfer\n", err)
+		}
+	}
+
+	if params.ExternalUID != "" {
+		t.Fatalf("Expected params not to be modified, received ExternalUID %q", params.ExternalUID)
+	}
+	// The generated key is not sent as an Idempotency-Key header, which net/http would replay on
+	// its own when a connection drops
+	for i := range keys {
+		if keys[i] != "" || externalUIDs[i] == "" {
+			t.Fatalf("Expected a generated ExternalUID and no Idempotency-Key header, received %q and %q", externalUIDs[i], keys[i])
+		}
+	}
+	if externalUIDs[0] == externalUIDs[1] {
+		t.Fatalf("Expected a new key for each request, received %q twice", externalUIDs[0])
+	}
+}
+
+func TestIdempotency_RecoversFromAmbiguousFailure(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		posts   int
+		lookups []string
+	)
+	client := newTestClient(t, &rize.Config{RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPost:
+			// Pretend the server failed after creating the Adjustment
+			posts++
+			w.WriteHeader(http.StatusGatewayTimeout)
+		case http.MethodGet:
+			lookup := r.URL.Query().Get("external_uid")
+			lookups = append(lookups, lookup)
+			existing := *adjustment
+			existing.ExternalUID = lookup
+			resp, _ := json.Marshal(&rize.AdjustmentListResponse{Data: []*rize.Adjustment{&existing}})
+			w.Write(resp)
+		}
+	})
+
+	params := &rize.AdjustmentCreateParams{
+		ExternalUID:         "client-generated-id",
+		CustomerUID:         "kbF5TGrmwGizQuzZ",
+		USDAdjustmentAmount: rize.MustParseMoney("2.43"),
+		AdjustmentTypeUID:   "KM2eKbR98t4tdAyZ",
+	}
+	resp, err := client.Adjustments.Create(context.Background(), params)
+	if err != nil {
+		t.Fatal("Expected existing Adjustment to be returned\n", err)
+	}
+
+	// The Adjustment is looked up before the request would be sent again
+	if resp.ExternalUID != "client-generated-id" {
+		t.Fatalf("Expected the existing Adjustment, received %+v", resp)
+	}
+	if posts != 1 || len(lookups) != 1 || lookups[0] != "client-generated-id" {
+		t.Fatalf("Expected one request and one lookup by ExternalUID, got %d requests and lookups %q", posts, lookups)
+	}
+}
+
+func TestIdempotency_RecoversFromDuplicateRejection(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		created bool
+		posts   int
+		lookups int
+	)
+	client := newTestClient(t, &rize.Config{RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPost:
+			posts++
+			if created {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"errors":[{"code":422,"title":"external_uid already exists"}],"status":422}`))
+				return
+			}
+			// Create the Transfer, then drop the connection before responding
+			created = true
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+		case http.MethodGet:
+			// The first lookup does not see the new Transfer yet
+			lookups++
+			list := &rize.TransferListResponse{}
+			if lookups > 1 {
+				existing := *transfer
+				existing.ExternalUID = r.URL.Query().Get("external_uid")
+				list.Data = []*rize.Transfer{&existing}
+			}
+			resp, _ := json.Marshal(list)
+			w.Write(resp)
+		}
+	})
+
+	params := &rize.TransferCreateParams{
+		ExternalUID:                    "client-generated-id",
+		SourceSyntheticAccountUID:      "4XkJnsfHsuqrxmeX",
+		DestinationSyntheticAccountUID: "exMDShw6yM3NHLYV",
+		InitiatingCustomerUID:          "iDtmSA52zRhgN4iy",
+		USDTransferAmount:              rize.MustParseMoney("12.34"),
+	}
+	resp, err := client.Transfers.Create(context.Background(), params)
+	if err != nil {
+		t.Fatal("Expected existing Transfer to be returned\n", err)
+	}
+
+	if resp.ExternalUID != "client-generated-id" || posts != 2 || lookups != 2 {
+		t.Fatalf("Expected the existing Transfer after 2 requests and 2 lookups, got %d requests and %d lookups", posts, lookups)
+	}
+}
+
+func TestIdempotency_LookupUsesCallOptions(t *testing.T) {
+	var lookupRequestID atomic.Value
+	lookupRequestID.Store("")
+	client := newTestClient(t, &rize.Config{RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusBadGateway)
+		case http.MethodGet:
+			lookupRequestID.Store(r.Header.Get("X-Request-Id"))
+			time.Sleep(time.Millisecond * 500)
+		}
+	})
+
+	params := &rize.DebitCardCreateParams{
+		CustomerUID: "kbF5TGrmwGizQuzZ",
+		PoolUID:     "HiuQZJNjCd79LLYq",
+	}
+	start := time.Now()
+	_, err := client.DebitCards.Create(context.Background(), params, rize.WithRequestID("request-123"), rize.WithCallTimeout(time.Millisecond*100))
+	if err == nil {
+		t.Fatal("Expected request to fail")
+	}
+	if elapsed := time.Since(start); elapsed > time.Millisecond*400 {
+		t.Fatalf("Expected the lookup to be bound by the call timeout, took %s", elapsed)
+	}
+	if id := lookupRequestID.Load(); id != "request-123" {
+		t.Fatalf("Expected the lookup to use the call options, received request ID %q", id)
+	}
+}
+
+func TestIdempotency_SkipsLookupOnClientError(t *testing.T) {
+	var lookups int
+	client := newTestClient(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			lookups++
+		}
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"errors":[],"status":422}`))
+	})
+
+	params := &rize.DebitCardCreateParams{
+		CustomerUID: "kbF5TGrmwGizQuzZ",
+		PoolUID:     "HiuQZJNjCd79LLYq",
+	}
+	if _, err := client.DebitCards.Create(context.Background(), params); err == nil {
+		t.Fatal("Expected request to fail")
+	}
+
+	if lookups != 0 {
+		t.Fatalf("Expected no lookup for a rejected request, got %d", lookups)
+	}
+}
@@ -0,0 +1,137 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+// Create a test client serving `total` Customers from the paginated Customers List endpoint.
+// Returns the client and the number of page requests received so far.
+func newPaginationTestClient(t *testing.T, total int) (*rize.Client, *int32) {
+	t.Helper()
+
+	var pages int32
+	client := newTestClient(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&pages, 1)
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		data := []*rize.Customer{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			data = append(data, &rize.Customer{UID: fmt.Sprintf("customer-%d", i)})
+		}
+
+		resp, _ := json.Marshal(&rize.CustomerListResponse{
+			ListResponse: rize.ListResponse{
+				TotalCount: total,
+				Count:      len(data),
+				Limit:      limit,
+				Offset:     offset,
+			},
+			Data: data,
+		})
+		w.Write(resp)
+	})
+
+	return client, &pages
+}
+
+func TestIterator_AllPages(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		client, pages := newPaginationTestClient(t, 25)
+
+		params := &rize.CustomerListParams{Limit: 10}
+		customers, err := client.Customers.ListAll(context.Background(), params, &rize.IteratorOptions{Prefetch: prefetch})
+		if err != nil {
+			t.Fatal("Error fetching customers\n", err)
+		}
+
+		if len(customers) != 25 {
+			t.Fatalf("Expected 25 customers, got %d", len(customers))
+		}
+		for i, c := range customers {
+			if want := fmt.Sprintf("customer-%d", i); c.UID != want {
+				t.Fatalf("Expected customer %q at index %d, got %q", want, i, c.UID)
+			}
+		}
+		if n := atomic.LoadInt32(pages); n != 3 {
+			t.Fatalf("Expected 3 page requests, got %d", n)
+		}
+		if params.Offset != 0 {
+			t.Fatalf("Expected params to be left untouched, got offset %d", params.Offset)
+		}
+	}
+}
+
+func TestIterator_MaxItems(t *testing.T) {
+	client, pages := newPaginationTestClient(t, 25)
+
+	it := client.Customers.Iterate(context.Background(), &rize.CustomerListParams{Limit: 10}, &rize.IteratorOptions{
+		MaxItems: 12,
+		Prefetch: true,
+	})
+
+	var count int
+	for it.Next() {
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal("Error iterating customers\n", err)
+	}
+
+	if count != 12 {
+		t.Fatalf("Expected 12 customers, got %d", count)
+	}
+	if n := atomic.LoadInt32(pages); n != 2 {
+		t.Fatalf("Expected 2 page requests, got %d", n)
+	}
+}
+
+func TestIterator_ContextCancelled(t *testing.T) {
+	client, _ := newPaginationTestClient(t, 25)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := client.Customers.Iterate(ctx, &rize.CustomerListParams{Limit: 10}, nil)
+
+	// Stop after the first page
+	for i := 0; i < 10; i++ {
+		if !it.Next() {
+			t.Fatal("Expected the first page of customers\n", it.Err())
+		}
+	}
+	cancel()
+
+	if it.Next() {
+		t.Fatal("Expected iteration to stop once the context is cancelled")
+	}
+	if it.Err() != context.Canceled {
+		t.Fatalf("Expected context.Canceled, got %v", it.Err())
+	}
+}
+
+func TestIterator_ListAll(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	fetch := func(ctx context.Context, offset int) ([]int, *rize.ListResponse, error) {
+		end := offset + 2
+		if end > len(items) {
+			end = len(items)
+		}
+		return items[offset:end], &rize.ListResponse{TotalCount: len(items)}, nil
+	}
+
+	all, err := rize.ListAll(context.Background(), 1, fetch, nil)
+	if err != nil {
+		t.Fatal("Error listing items\n", err)
+	}
+	if fmt.Sprint(all) != "[2 3 4 5]" {
+		t.Fatalf("Expected items starting at offset 1, got %v", all)
+	}
+}
@@ -0,0 +1,124 @@
+package rize_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rizefinance/rize-go-sdk"
+	"github.com/rizefinance/rize-go-sdk/mq"
+)
+
+// Records all log entries as `LEVEL msg key=value ...`
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (l *recordingLogger) record(level string, msg string, keysAndValues []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, strings.TrimSpace(fmt.Sprintln(append([]interface{}{level, msg}, keysAndValues...)...)))
+}
+
+func (l *recordingLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.record("DEBUG", msg, keysAndValues)
+}
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.record("INFO", msg, keysAndValues)
+}
+
+func (l *recordingLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.record("WARN", msg, keysAndValues)
+}
+
+func (l *recordingLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.record("ERROR", msg, keysAndValues)
+}
+
+func (l *recordingLogger) contains(entry string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, e := range l.entries {
+		if strings.HasPrefix(e, entry) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestLogger_GlobalLogUntouched(t *testing.T) {
+	var buf bytes.Buffer
+	output, flags, prefix := log.Writer(), log.Flags(), log.Prefix()
+	log.SetOutput(&buf)
+	defer func() {
+		log.SetOutput(output)
+		log.SetFlags(flags)
+		log.SetPrefix(prefix)
+	}()
+
+	newTestClient(t, &rize.Config{Debug: false}, func(w http.ResponseWriter, r *http.Request) {})
+	if _, err := mq.NewClient(&mq.Config{Username: "username", Password: "password", ClientID: "client_id"}); err != nil {
+		t.Fatal("Error creating MQ client\n", err)
+	}
+
+	log.Print("application log")
+	if !strings.Contains(buf.String(), "application log") {
+		t.Fatal("Expected the global logger to keep writing after creating clients")
+	}
+	if log.Flags() != flags || log.Prefix() != prefix {
+		t.Fatal("Expected the global logger settings to be left untouched")
+	}
+}
+
+func TestLogger_Injected(t *testing.T) {
+	logger := &recordingLogger{}
+	client := newTestClient(t, &rize.Config{Logger: logger, RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+
+	if !logger.contains("DEBUG Sending request method GET") {
+		t.Fatalf("Expected a debug entry for the request, got %q", logger.entries)
+	}
+	if !logger.contains("WARN Request failed, retrying method GET") {
+		t.Fatalf("Expected a warning for the retried request, got %q", logger.entries)
+	}
+}
+
+func TestLogger_StdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := rize.NewStdLogger(log.New(&buf, "", 0), rize.LevelInfo)
+
+	logger.Debug("hidden")
+	logger.Info("Request sent", "status", 200, "path", "customers")
+	logger.Error("unpaired", "key")
+
+	want := "[INFO] Request sent status=200 path=customers\n[ERROR] unpaired !BADKEY=key\n"
+	if buf.String() != want {
+		t.Fatalf("Expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestLogger_StdLoggerCaller(t *testing.T) {
+	var buf bytes.Buffer
+	newTestClient(t, &rize.Config{
+		Logger: rize.NewStdLogger(log.New(&buf, "", log.Lshortfile), rize.LevelDebug),
+	}, func(w http.ResponseWriter, r *http.Request) {})
+
+	// Entries report the SDK call site, not the redacting wrapper
+	out := buf.String()
+	if out == "" || strings.Contains(out, "redact.go") || strings.Contains(out, "logger.go") {
+		t.Fatalf("Expected the caller of the logger to be reported, got %q", out)
+	}
+	if !strings.HasPrefix(out, "client.go:") {
+		t.Fatalf("Expected entries to start with the SDK call site, got %q", out)
+	}
+}
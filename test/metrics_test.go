@@ -0,0 +1,112 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+// Keeps all measurements in memory
+type recordingMetrics struct {
+	mu        sync.Mutex
+	requests  []rize.RequestMetrics
+	refreshes []rize.TokenRefreshMetrics
+}
+
+func (r *recordingMetrics) RecordRequest(m rize.RequestMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, m)
+}
+
+func (r *recordingMetrics) RecordTokenRefresh(m rize.TokenRefreshMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.refreshes = append(r.refreshes, m)
+}
+
+func TestMetrics_RecordRequest(t *testing.T) {
+	metrics := &recordingMetrics{}
+
+	var requests int
+	client := newTestClient(t, &rize.Config{Metrics: metrics, RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		resp, _ := json.Marshal(transfer)
+		w.Write(resp)
+	})
+
+	if _, err := client.Transfers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+		t.Fatal("Error fetching transfer\n", err)
+	}
+
+	if len(metrics.refreshes) != 1 || metrics.refreshes[0].Err != nil {
+		t.Fatalf("Expected a single successful token refresh, got %+v", metrics.refreshes)
+	}
+	if len(metrics.requests) != 1 {
+		t.Fatalf("Expected a single request measurement, got %d", len(metrics.requests))
+	}
+
+	m := metrics.requests[0]
+	if m.Operation != "Transfers.Get" || m.Method != http.MethodGet {
+		t.Fatalf("Expected the Transfers.Get operation, got %s %s", m.Method, m.Operation)
+	}
+	if m.Status != http.StatusOK || m.Retries != 1 || m.Err != nil || m.Duration <= 0 {
+		t.Fatalf("Expected a successful request with 1 retry, got %+v", m)
+	}
+}
+
+func TestMetrics_RecordRequestError(t *testing.T) {
+	metrics := &recordingMetrics{}
+	client := newTestClient(t, &rize.Config{Metrics: metrics, RetryPolicy: noRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+
+	if m := metrics.requests[0]; m.Operation != "Customers.Get" || m.Status != http.StatusNotFound || m.Err == nil {
+		t.Fatalf("Expected a failed Customers.Get request, got %+v", m)
+	}
+}
+
+func TestMetrics_PrometheusRecorder(t *testing.T) {
+	recorder := rize.NewPrometheusRecorder("", []float64{0.1, 1})
+
+	recorder.RecordRequest(rize.RequestMetrics{Operation: "Transfers.Create", Status: 201, Duration: time.Millisecond * 50, Retries: 2})
+	recorder.RecordRequest(rize.RequestMetrics{Operation: "Transfers.Create", Status: 201, Duration: time.Millisecond * 500})
+	recorder.RecordRequest(rize.RequestMetrics{Operation: "Customers.Get", Duration: time.Second * 2})
+	recorder.RecordTokenRefresh(rize.TokenRefreshMetrics{})
+
+	w := httptest.NewRecorder()
+	recorder.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	output := w.Body.String()
+
+	for _, line := range []string{
+		`# TYPE rize_requests_total counter`,
+		`rize_requests_total{operation="Customers.Get",status="error"} 1`,
+		`rize_requests_total{operation="Transfers.Create",status="201"} 2`,
+		`rize_request_duration_seconds_bucket{operation="Transfers.Create",le="0.1"} 1`,
+		`rize_request_duration_seconds_bucket{operation="Transfers.Create",le="1"} 2`,
+		`rize_request_duration_seconds_bucket{operation="Customers.Get",le="+Inf"} 1`,
+		`rize_request_duration_seconds_count{operation="Transfers.Create"} 2`,
+		`rize_request_retries_total{operation="Transfers.Create"} 2`,
+		`rize_token_refreshes_total{result="success"} 1`,
+	} {
+		if !strings.Contains(output, line+"\n") {
+			t.Fatalf("Expected metrics output to contain %q, got\n%s", line, output)
+		}
+	}
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Fatalf("Expected text/plain content type, got %q", ct)
+	}
+}
@@ -0,0 +1,178 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+func TestMiddleware_Order(t *testing.T) {
+	var calls []string
+	record := func(name string) rize.Middleware {
+		return func(next rize.Handler) rize.Handler {
+			return func(ctx context.Context, req *rize.Request) (*rize.Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next(ctx, req)
+				calls = append(calls, name+" after")
+				return resp, err
+			}
+		}
+	}
+
+	client := newTestClient(t, &rize.Config{
+		Middleware: []rize.Middleware{record("outer"), record("inner")},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	})
+
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+
+	expected := []string{"outer before", "inner before", "inner after", "outer after"}
+	if len(calls) != len(expected) {
+		t.Fatalf("Expected calls %v, received %v", expected, calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Fatalf("Expected calls %v, received %v", expected, calls)
+		}
+	}
+}
+
+func TestMiddleware_Request(t *testing.T) {
+	var (
+		req  *rize.Request
+		resp *rize.Response
+	)
+	client := newTestClient(t, &rize.Config{
+		Middleware: []rize.Middleware{func(next rize.Handler) rize.Handler {
+			return func(ctx context.Context, r *rize.Request) (*rize.Response, error) {
+				r.Header.Set("X-Audit-ID", "audit-123")
+				res, err := next(ctx, r)
+				req, resp = r, res
+				return res, err
+			}
+		}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Audit-ID") != "audit-123" {
+			t.Errorf("Expected X-Audit-ID header, received %q", r.Header.Get("X-Audit-ID"))
+		}
+		resp, _ := json.Marshal(&rize.CustomerListResponse{Data: []*rize.Customer{customer}})
+		w.Write(resp)
+	})
+
+	params := &rize.CustomerListParams{Status: rize.CustomerStatusActive}
+	if _, err := client.Customers.List(context.Background(), params); err != nil {
+		t.Fatal("Error fetching customers\n", err)
+	}
+
+	if req.Operation.Name != "Customers.List" {
+		t.Fatalf("Expected operation Customers.List, received %s", req.Operation.Name)
+	}
+	if req.Params != params {
+		t.Fatalf("Expected typed params, received %#v", req.Params)
+	}
+	if req.Query.Get("status") != "active" {
+		t.Fatalf("Expected status query param, received %v", req.Query)
+	}
+	if resp.HTTPResponse.StatusCode != http.StatusOK || resp.HTTPRequest.Header.Get("X-Audit-ID") != "audit-123" {
+		t.Fatal("Expected raw HTTP request and response")
+	}
+	list, ok := resp.Result.(*rize.CustomerListResponse)
+	if !ok || len(list.Data) != 1 || list.Data[0].UID != customer.UID {
+		t.Fatalf("Expected decoded CustomerListResponse, received %#v", resp.Result)
+	}
+}
+
+func TestMiddleware_ShortCircuit(t *testing.T) {
+	var requests int32
+	cached, _ := json.Marshal(customer)
+
+	client := newTestClient(t, &rize.Config{
+		Middleware: []rize.Middleware{func(next rize.Handler) rize.Handler {
+			return func(ctx context.Context, req *rize.Request) (*rize.Response, error) {
+				if req.Operation.Name == "Customers.Get" {
+					return &rize.Response{Body: cached}, nil
+				}
+				return next(ctx, req)
+			}
+		}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	})
+
+	c, err := client.Customers.Get(context.Background(), customer.UID)
+	if err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+	if c.UID != customer.UID {
+		t.Fatalf("Expected cached customer %s, received %s", customer.UID, c.UID)
+	}
+	if n := atomic.LoadInt32(&requests); n != 0 {
+		t.Fatalf("Expected no requests, received %d", n)
+	}
+}
+
+func TestMiddleware_Error(t *testing.T) {
+	injected := errors.New("injected fault")
+
+	client := newTestClient(t, &rize.Config{
+		Middleware: []rize.Middleware{func(next rize.Handler) rize.Handler {
+			return func(ctx context.Context, req *rize.Request) (*rize.Response, error) {
+				return nil, injected
+			}
+		}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request should not be sent")
+	})
+
+	if _, err := client.Customers.Get(context.Background(), customer.UID); !errors.Is(err, injected) {
+		t.Fatalf("Expected injected error, received %v", err)
+	}
+}
+
+func TestMiddleware_ShortCircuitRawResponse(t *testing.T) {
+	client := newTestClient(t, &rize.Config{
+		Middleware: []rize.Middleware{func(next rize.Handler) rize.Handler {
+			return func(ctx context.Context, req *rize.Request) (*rize.Response, error) {
+				return &rize.Response{Body: []byte("%PDF-1.4")}, nil
+			}
+		}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request should not be sent")
+	})
+
+	// Methods returning the raw response get an http.Response built from the middleware's response
+	res, err := client.Documents.View(context.Background(), "EhrQZJNjCd79LLYq")
+	if err != nil {
+		t.Fatal("Error viewing document\n", err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK || string(body) != "%PDF-1.4" {
+		t.Fatalf("Unexpected response %d %q", res.StatusCode, body)
+	}
+}
+
+func TestMiddleware_NilResponse(t *testing.T) {
+	client := newTestClient(t, &rize.Config{
+		Middleware: []rize.Middleware{func(next rize.Handler) rize.Handler {
+			return func(ctx context.Context, req *rize.Request) (*rize.Response, error) {
+				return nil, nil
+			}
+		}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request should not be sent")
+	})
+
+	if _, err := client.Customers.Get(context.Background(), customer.UID); err == nil {
+		t.Fatal("Expected an error for a middleware returning no response")
+	}
+}
@@ -0,0 +1,127 @@
+package rize_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+func TestMoney_Parse(t *testing.T) {
+	tests := map[string]string{
+		"12.34":                    "12.34",
+		"12.30":                    "12.30",
+		"-0.5":                     "-0.5",
+		"+7":                       "7",
+		".25":                      "0.25",
+		"1e3":                      "1000",
+		"1.5e-2":                   "0.015",
+		"0.00":                     "0.00",
+		"100000000000000000000.01": "100000000000000000000.01",
+	}
+	for input, expected := range tests {
+		m, err := rize.ParseMoney(input)
+		if err != nil {
+			t.Fatalf("Error parsing %q\n%v", input, err)
+		}
+		if m.String() != expected {
+			t.Fatalf("Expected %q to be %s, received %s", input, expected, m)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "1.2.3", "$5", "-", "."} {
+		if _, err := rize.ParseMoney(input); err == nil {
+			t.Errorf("Expected error parsing %q", input)
+		}
+	}
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	a := rize.MustParseMoney("0.1")
+	b := rize.MustParseMoney("0.2")
+
+	// 0.1 + 0.2 is exact, unlike float64
+	if sum := a.Add(b); sum.String() != "0.3" || !sum.Equal(rize.MustParseMoney("0.30")) {
+		t.Fatalf("Expected 0.1 + 0.2 = 0.3, received %s", sum)
+	}
+	if diff := a.Sub(rize.NewMoney(125, 2)); diff.String() != "-1.15" {
+		t.Fatalf("Expected 0.1 - 1.25 = -1.15, received %s", diff)
+	}
+	if a.Cmp(b) != -1 || b.Cmp(a) != 1 || a.Cmp(rize.MustParseMoney("0.100")) != 0 {
+		t.Fatal("Unexpected comparison result")
+	}
+	if !(rize.Money{}).IsZero() || rize.MustParseMoney("-3").Sign() != -1 || rize.MustParseMoney("-3").Abs().String() != "3" {
+		t.Fatal("Unexpected sign result")
+	}
+}
+
+func TestMoney_StringFixed(t *testing.T) {
+	tests := []struct {
+		input    string
+		places   int
+		expected string
+	}{
+		{"12.345", 2, "12.35"},
+		{"-12.345", 2, "-12.35"},
+		{"12.344", 2, "12.34"},
+		{"12", 2, "12.00"},
+		{"0.005", 2, "0.01"},
+		{"9.99", 0, "10"},
+	}
+	for _, tt := range tests {
+		if s := rize.MustParseMoney(tt.input).StringFixed(tt.places); s != tt.expected {
+			t.Errorf("Expected %s.StringFixed(%d) = %s, received %s", tt.input, tt.places, tt.expected, s)
+		}
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	var transfer rize.Transfer
+	if err := json.Unmarshal([]byte(`{"usd_transfer_amount":"12.30","usd_requested_amount":0.1}`), &transfer); err != nil {
+		t.Fatal("Error unmarshalling Transfer\n", err)
+	}
+	if transfer.USDTransferAmount.String() != "12.30" || transfer.USDRequestedAmount.String() != "0.1" {
+		t.Fatalf("Unexpected amounts %s %s", transfer.USDTransferAmount, transfer.USDRequestedAmount)
+	}
+
+	b, _ := json.Marshal(rize.TransferCreateParams{USDTransferAmount: rize.MustParseMoney("12.30")})
+	var body map[string]interface{}
+	json.Unmarshal(b, &body)
+	if body["usd_transfer_amount"] != "12.30" {
+		t.Fatalf("Expected amount to be sent as a string, received %s", b)
+	}
+
+	for _, v := range []string{`null`, `""`} {
+		var m rize.Money
+		if err := json.Unmarshal([]byte(v), &m); err != nil || !m.IsZero() {
+			t.Fatalf("Expected %s to decode to zero, received %s (%v)", v, m, err)
+		}
+	}
+
+	// The sandbox API expects a number
+	b, _ = json.Marshal(rize.SandboxCreateParams{USDollarAmount: rize.MustParseMoney("21.89")})
+	json.Unmarshal(b, &body)
+	if body["us_dollar_amount"] != 21.89 {
+		t.Fatalf("Expected sandbox amount to be sent as a number, received %s", b)
+	}
+}
+
+func TestMoney_Query(t *testing.T) {
+	v, err := query.Values(&rize.AdjustmentListParams{USDAdjustmentAmountMin: rize.Some(rize.MustParseMoney("5.50"))})
+	if err != nil {
+		t.Fatal("Error encoding params\n", err)
+	}
+	if v.Get("usd_adjustment_amount_min") != "5.50" {
+		t.Fatalf("Expected min amount 5.50, received %v", v)
+	}
+	if _, ok := v["usd_adjustment_amount_max"]; ok {
+		t.Fatalf("Expected unset max amount to be omitted, received %v", v)
+	}
+
+	// A zero amount can be filtered for
+	v, _ = query.Values(&rize.CustodialLineItemListParams{USDollarAmountMin: rize.Some(rize.NewMoney(0, 0))})
+	if v.Get("us_dollar_amount_min") != "0" {
+		t.Fatalf("Expected min amount 0, received %v", v)
+	}
+}
@@ -0,0 +1,77 @@
+package rize_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+func TestOptional_State(t *testing.T) {
+	var unset rize.Optional[bool]
+	if unset.IsSet() || unset.IsNull() || !unset.IsZero() {
+		t.Fatal("Expected the zero Optional to be unset")
+	}
+
+	if v, ok := rize.Some(false).Get(); !ok || v {
+		t.Fatal("Expected Some(false) to hold false")
+	}
+
+	null := rize.Null[string]()
+	if null.IsSet() || !null.IsNull() || null.IsZero() || null.Value() != "" {
+		t.Fatal("Expected Null() to be null")
+	}
+}
+
+func TestOptional_Query(t *testing.T) {
+	// Unset params are left out
+	v, err := query.Values(&rize.CustomerListParams{})
+	if err != nil {
+		t.Fatal("Error encoding params\n", err)
+	}
+	if v.Has("locked") || v.Has("include_initiated") {
+		t.Fatalf("Expected unset params to be omitted, received %s", v.Encode())
+	}
+
+	// False can be filtered for
+	v, _ = query.Values(&rize.DebitCardListParams{Locked: rize.Some(false)})
+	if v.Get("locked") != "false" {
+		t.Fatalf("Expected locked=false, received %s", v.Encode())
+	}
+	v, _ = query.Values(&rize.SyntheticAccountListParams{Liability: rize.Some(true)})
+	if v.Get("liability") != "true" {
+		t.Fatalf("Expected liability=true, received %s", v.Encode())
+	}
+}
+
+func TestOptional_UpdateParams(t *testing.T) {
+	tests := []struct {
+		params   interface{}
+		expected string
+	}{
+		{&rize.CustomerUpdateParams{}, `{}`},
+		{&rize.CustomerUpdateParams{Email: rize.Some("olive.oyl@rizemoney.com")}, `{"email":"olive.oyl@rizemoney.com"}`},
+		{&rize.CustomerUpdateParams{ExternalUID: rize.Null[string]()}, `{"external_uid":null}`},
+		{&rize.SyntheticAccountUpdateParams{Name: rize.Some(""), Note: rize.Null[string]()}, `{"name":"","note":null}`},
+	}
+	for _, tt := range tests {
+		out, err := json.Marshal(tt.params)
+		if err != nil {
+			t.Fatal("Error encoding params\n", err)
+		}
+		if string(out) != tt.expected {
+			t.Errorf("Expected %s, received %s", tt.expected, out)
+		}
+	}
+}
+
+func TestOptional_UnmarshalJSON(t *testing.T) {
+	var params rize.SyntheticAccountUpdateParams
+	if err := json.Unmarshal([]byte(`{"name":"Savings","note":null}`), &params); err != nil {
+		t.Fatal("Error decoding params\n", err)
+	}
+	if params.Name.Value() != "Savings" || !params.Note.IsNull() {
+		t.Fatalf("Unexpected params %+v", params)
+	}
+}
@@ -0,0 +1,175 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk"
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// Returns a mock server that counts auth requests and responds to all other requests with a customer
+func newOptionsTestServer(t *testing.T, authRequests *int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+internal.BasePath+"/auth" {
+			atomic.AddInt32(authRequests, 1)
+			resp, _ := json.Marshal(tokenResponse)
+			w.Write(resp)
+			return
+		}
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewClient_Options(t *testing.T) {
+	var authRequests int32
+	server := newOptionsTestServer(t, &authRequests)
+	httpClient := &http.Client{Timeout: time.Second}
+
+	client, err := rize.NewClient(
+		rize.WithCredentials("program_uid", "hmac_key"),
+		rize.WithEnvironment("sandbox"),
+		rize.WithBaseURL(server.URL),
+		rize.WithHTTPClient(httpClient),
+		rize.WithRetryPolicy(noRetryPolicy),
+	)
+	if err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+	if n := atomic.LoadInt32(&authRequests); n != 1 {
+		t.Fatalf("Expected 1 auth request, received %d", n)
+	}
+
+	if _, err := client.Customers.Get(context.Background(), customer.UID); err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+}
+
+func TestNewClient_ConfigAndOptions(t *testing.T) {
+	var authRequests int32
+	server := newOptionsTestServer(t, &authRequests)
+
+	// Options passed after the Config override its values
+	_, err := rize.NewClient(&rize.Config{
+		ProgramUID:  "program_uid",
+		HMACKey:     "hmac_key",
+		Environment: "sandbox",
+		BaseURL:     "http://127.0.0.1:0",
+	}, rize.WithBaseURL(server.URL), rize.WithTimeout(time.Second))
+	if err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+	if n := atomic.LoadInt32(&authRequests); n != 1 {
+		t.Fatalf("Expected 1 auth request, received %d", n)
+	}
+}
+
+func TestNewClient_ReusedConfig(t *testing.T) {
+	var authRequests int32
+	server := newOptionsTestServer(t, &authRequests)
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond * 200)
+	}))
+	t.Cleanup(slow.Close)
+
+	cfg := &rize.Config{
+		ProgramUID:  "program_uid",
+		HMACKey:     "hmac_key",
+		Environment: "sandbox",
+		BaseURL:     server.URL,
+		RetryPolicy: noRetryPolicy,
+		LazyAuth:    true,
+	}
+	if _, err := rize.NewClient(cfg); err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+
+	// Options passed with the Config apply to the new Client only
+	var calls int32
+	middleware := func(next rize.Handler) rize.Handler {
+		return func(ctx context.Context, req *rize.Request) (*rize.Response, error) {
+			atomic.AddInt32(&calls, 1)
+			return next(ctx, req)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		client, err := rize.NewClient(cfg, rize.WithMiddleware(middleware))
+		if err != nil {
+			t.Fatal("Error creating client\n", err)
+		}
+		atomic.StoreInt32(&calls, 0)
+		if _, err := client.Customers.Get(context.Background(), customer.UID); err != nil {
+			t.Fatal("Error fetching customer\n", err)
+		}
+		if n := atomic.LoadInt32(&calls); n != 1 {
+			t.Fatalf("Expected the middleware to run once, ran %d times", n)
+		}
+	}
+	if len(cfg.Middleware) != 0 {
+		t.Fatalf("Expected the Config to be unchanged, received %d middleware", len(cfg.Middleware))
+	}
+
+	// The HTTPClient created for the first Client does not keep its timeout
+	client, err := rize.NewClient(cfg, rize.WithBaseURL(slow.URL), rize.WithTimeout(time.Millisecond*50))
+	if err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+	if _, err := client.Customers.Get(context.Background(), customer.UID); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the request to time out, received %v", err)
+	}
+}
+
+func TestNewClient_LazyAuth(t *testing.T) {
+	var authRequests int32
+	server := newOptionsTestServer(t, &authRequests)
+
+	client, err := rize.NewClient(
+		rize.WithCredentials("program_uid", "hmac_key"),
+		rize.WithBaseURL(server.URL),
+		rize.WithLazyAuth(),
+	)
+	if err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+	if n := atomic.LoadInt32(&authRequests); n != 0 {
+		t.Fatalf("Expected no auth requests, received %d", n)
+	}
+
+	if _, err := client.Customers.Get(context.Background(), customer.UID); err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+	if n := atomic.LoadInt32(&authRequests); n != 1 {
+		t.Fatalf("Expected 1 auth request, received %d", n)
+	}
+}
+
+func TestNewClient_LazyAuthUnreachable(t *testing.T) {
+	client, err := rize.NewClient(
+		rize.WithCredentials("program_uid", "hmac_key"),
+		rize.WithBaseURL("http://127.0.0.1:1"),
+		rize.WithRetryPolicy(noRetryPolicy),
+		rize.WithLazyAuth(),
+	)
+	if err != nil {
+		t.Fatal("Expected client to be created while the API is unreachable\n", err)
+	}
+
+	if _, err := client.Customers.Get(context.Background(), customer.UID); err == nil {
+		t.Fatal("Expected error from unreachable API")
+	}
+}
+
+func TestNewClient_MissingCredentials(t *testing.T) {
+	if _, err := rize.NewClient(rize.WithLazyAuth()); err == nil {
+		t.Fatal("Expected error for missing credentials")
+	}
+}
@@ -0,0 +1,228 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+// Returns a handler that tracks the highest number of concurrent requests per endpoint group
+func concurrencyHandler(delay time.Duration) (http.HandlerFunc, func(group string) int32) {
+	var (
+		mu      sync.Mutex
+		current = map[string]int32{}
+		peak    = map[string]int32{}
+	)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		group := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")[0]
+
+		mu.Lock()
+		current[group]++
+		if current[group] > peak[group] {
+			peak[group] = current[group]
+		}
+		mu.Unlock()
+
+		time.Sleep(delay)
+
+		mu.Lock()
+		current[group]--
+		mu.Unlock()
+
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	}
+
+	return handler, func(group string) int32 {
+		mu.Lock()
+		defer mu.Unlock()
+		return peak[group]
+	}
+}
+
+// Send `n` concurrent requests with the given function
+func concurrently(n int, fn func()) {
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRateLimit_RequestsPerSecond(t *testing.T) {
+	client := newTestClient(t, &rize.Config{
+		RateLimit: &rize.RateLimit{RequestsPerSecond: 50, Burst: 1},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	})
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+			t.Fatal("Error fetching customer\n", err)
+		}
+	}
+
+	// One request every 20ms
+	if elapsed := time.Since(start); elapsed < time.Millisecond*90 {
+		t.Fatalf("Expected requests to be throttled, 6 requests took %s", elapsed)
+	}
+}
+
+func TestRateLimit_MaxInFlight(t *testing.T) {
+	handler, peak := concurrencyHandler(time.Millisecond * 20)
+	client := newTestClient(t, &rize.Config{RateLimit: &rize.RateLimit{MaxInFlight: 2}}, handler)
+
+	concurrently(10, func() {
+		client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	})
+
+	if n := peak("customers"); n != 2 {
+		t.Fatalf("Expected at most 2 requests in flight, got %d", n)
+	}
+}
+
+func TestRateLimit_Groups(t *testing.T) {
+	handler, peak := concurrencyHandler(time.Millisecond * 20)
+	client := newTestClient(t, &rize.Config{
+		RateLimit: &rize.RateLimit{
+			Groups: map[string]*rize.RateLimit{
+				"customers": {MaxInFlight: 1},
+			},
+		},
+	}, handler)
+
+	concurrently(5, func() {
+		client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	})
+	concurrently(5, func() {
+		client.Transfers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	})
+
+	if n := peak("customers"); n != 1 {
+		t.Fatalf("Expected 1 customers request in flight, got %d", n)
+	}
+	if n := peak("transfers"); n < 2 {
+		t.Fatalf("Expected transfers requests to be unlimited, got at most %d in flight", n)
+	}
+}
+
+func TestRateLimit_ContextDeadline(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, &rize.Config{
+		RateLimit: &rize.RateLimit{RequestsPerSecond: 1, Burst: 1},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{}`))
+	})
+
+	// The only token is used by the first request
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Customers.Get(ctx, "EhrQZJNjCd79LLYq")
+	if !errors.Is(err, rize.ErrRateLimitWait) {
+		t.Fatal("Expected ErrRateLimitWait\n", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Millisecond*50 {
+		t.Fatalf("Expected the request to fail before the deadline, took %s", elapsed)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("Expected only the first request to be sent, got %d", n)
+	}
+}
+
+func TestRateLimit_CreateNotRecovered(t *testing.T) {
+	var ops []string
+	client := newTestClient(t, &rize.Config{
+		RateLimit:   &rize.RateLimit{RequestsPerSecond: 1, Burst: 1},
+		RetryPolicy: noRetryPolicy,
+		Middleware: []rize.Middleware{func(next rize.Handler) rize.Handler {
+			return func(ctx context.Context, req *rize.Request) (*rize.Response, error) {
+				ops = append(ops, req.Operation.Name)
+				return next(ctx, req)
+			}
+		}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+
+	// A create that was never sent is not looked up by its external UID
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	if _, err := client.Customers.Create(ctx, &rize.CustomerCreateParams{Email: "olive.oyl@rizemoney.com"}); !errors.Is(err, rize.ErrRateLimitWait) {
+		t.Fatal("Expected ErrRateLimitWait\n", err)
+	}
+	if strings.Join(ops, ",") != "Customers.Get,Customers.Create" {
+		t.Fatalf("Expected no lookup after the create, received %v", ops)
+	}
+}
+
+func TestRateLimit_AuthNotLimited(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, &rize.Config{
+		RateLimit: &rize.RateLimit{RequestsPerSecond: 0.1, Burst: 1},
+		LazyAuth:  true,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{}`))
+	})
+
+	// The token refresh does not use up the only token
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := client.Customers.Get(ctx, "EhrQZJNjCd79LLYq"); err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("Expected 1 request, got %d", n)
+	}
+}
+
+func TestRateLimit_TooManyRequests(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, &rize.Config{
+		RetryPolicy: noRetryPolicy,
+		RateLimit:   &rize.RateLimit{RequestsPerSecond: 1000, Burst: 10},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	})
+
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); !errors.Is(err, rize.ErrRateLimited) {
+		t.Fatal("Expected a rate limited error\n", err)
+	}
+
+	// The next request waits for the Retry-After time
+	start := time.Now()
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Millisecond*900 {
+		t.Fatalf("Expected the request to wait for Retry-After, took %s", elapsed)
+	}
+}
@@ -0,0 +1,96 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+func TestResponse_Metadata(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, &rize.Config{RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first attempt, so the call is retried
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("X-Amzn-Requestid", "request-123")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	})
+
+	var resp rize.Response
+	if _, err := client.Customers.Get(context.Background(), customer.UID, rize.WithResponse(&resp)); err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, received %d", http.StatusOK, resp.StatusCode)
+	}
+	if resp.RequestID != "request-123" {
+		t.Fatalf("Expected request ID %q, received %q", "request-123", resp.RequestID)
+	}
+	if resp.Header.Get("X-Amzn-Requestid") != "request-123" {
+		t.Fatal("Expected response headers")
+	}
+	if resp.RateLimit.Limit != 100 || resp.RateLimit.Remaining != 99 || resp.RateLimit.Reset.Unix() != 1700000000 {
+		t.Fatalf("Unexpected rate limit status %+v", resp.RateLimit)
+	}
+	if resp.Attempts != 2 {
+		t.Fatalf("Expected 2 attempts, received %d", resp.Attempts)
+	}
+	if resp.Duration <= 0 {
+		t.Fatal("Expected call duration")
+	}
+}
+
+func TestResponse_Error(t *testing.T) {
+	client := newTestClient(t, &rize.Config{RetryPolicy: noRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "request-404")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":404,"title":"Not Found"}],"status":404}`))
+	})
+
+	var resp rize.Response
+	_, err := client.Customers.Get(context.Background(), customer.UID, rize.WithResponse(&resp))
+	if !errors.Is(err, rize.ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound, received %v", err)
+	}
+	if resp.StatusCode != http.StatusNotFound || resp.RequestID != "request-404" {
+		t.Fatalf("Expected error status and request ID, received %d %q", resp.StatusCode, resp.RequestID)
+	}
+}
+
+func TestResponse_Delete(t *testing.T) {
+	client := newTestClient(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, received %s", r.Method)
+		}
+		w.Header().Set("X-Request-Id", "request-204")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var resp rize.Response
+	result, err := client.SyntheticAccounts.Delete(context.Background(), "exMDShw6yM3NHLYV", rize.WithResponse(&resp))
+	if err != nil {
+		t.Fatal("Error deleting Synthetic Account\n", err)
+	}
+
+	if result.UID != "exMDShw6yM3NHLYV" {
+		t.Fatalf("Expected UID %q, received %q", "exMDShw6yM3NHLYV", result.UID)
+	}
+	if result.Response.StatusCode != http.StatusNoContent || result.Response.RequestID != "request-204" {
+		t.Fatalf("Expected status and request ID, received %d %q", result.Response.StatusCode, result.Response.RequestID)
+	}
+	if resp.RequestID != "request-204" {
+		t.Fatalf("Expected caller's response to be filled in, received %q", resp.RequestID)
+	}
+}
@@ -0,0 +1,135 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+// Retry policy with short delays for testing
+var testRetryPolicy = &rize.RetryPolicy{
+	MaxAttempts: 3,
+	BaseBackoff: time.Millisecond,
+	MaxBackoff:  time.Millisecond * 5,
+}
+
+func TestRetryPolicy_RetriesTransientErrors(t *testing.T) {
+	var attempts int32
+	client := newTestClient(t, &rize.Config{RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	})
+
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+		t.Fatal("Expected request to succeed after retrying\n", err)
+	}
+
+	if attempts != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryPolicy_MaxAttempts(t *testing.T) {
+	var attempts int32
+	client := newTestClient(t, &rize.Config{RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(`{"errors":[],"status":502}`))
+	})
+
+	_, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	if rerr, ok := err.(*rize.Error); !ok || rerr.Status != http.StatusBadGateway {
+		t.Fatal("Expected a 502 Rize API error\n", err)
+	}
+
+	if attempts != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryPolicy_RetryAfter(t *testing.T) {
+	var attempts int32
+	policy := &rize.RetryPolicy{MaxAttempts: 3, MaxBackoff: time.Second * 2}
+	client := newTestClient(t, &rize.Config{RetryPolicy: policy}, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	})
+
+	start := time.Now()
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+		t.Fatal("Expected request to succeed after retrying\n", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Expected Retry-After delay to be honored, request completed in %s", elapsed)
+	}
+}
+
+func TestRetryPolicy_RetryAfterExceedsMaxBackoff(t *testing.T) {
+	var attempts int32
+	client := newTestClient(t, &rize.Config{RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"errors":[],"status":429}`))
+	})
+
+	// The 429 is returned instead of waiting for an hour
+	_, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+	if !errors.Is(err, rize.ErrRateLimited) {
+		t.Fatalf("Expected ErrRateLimited, received %v", err)
+	}
+	if n := atomic.LoadInt32(&attempts); n != 1 {
+		t.Fatalf("Expected 1 attempt, got %d", n)
+	}
+}
+
+func TestRetryPolicy_ContextCancelled(t *testing.T) {
+	policy := &rize.RetryPolicy{MaxAttempts: 3, MaxBackoff: time.Minute * 2}
+	client := newTestClient(t, &rize.Config{RetryPolicy: policy}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	if _, err := client.Customers.Get(ctx, "EhrQZJNjCd79LLYq"); err != context.DeadlineExceeded {
+		t.Fatal("Expected context deadline to interrupt the retry delay\n", err)
+	}
+}
+
+func TestRetryPolicy_SkipsUnsafeMethods(t *testing.T) {
+	var attempts int32
+	client := newTestClient(t, &rize.Config{RetryPolicy: testRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"errors":[],"status":503}`))
+	})
+
+	params := &rize.CustomerLockParams{
+		LockReason: "Customer Reported Fraud",
+	}
+	if _, err := client.Customers.Lock(context.Background(), "EhrQZJNjCd79LLYq", params); err == nil {
+		t.Fatal("Expected request to fail")
+	}
+
+	if attempts != 1 {
+		t.Fatalf("Expected a single attempt, got %d", attempts)
+	}
+}
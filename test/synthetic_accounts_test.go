@@ -20,13 +20,13 @@ var syntheticAccount = &rize.SyntheticAccount{
 	SyntheticAccountCategory: "general",
 	Status:                   "active",
 	Liability:                true,
-	NetUSDBalance:            "769.65",
-	NetUSDPendingBalance:     "343.16",
-	NetUSDAvailableBalance:   "701.46",
+	NetUSDBalance:            rize.MustParseMoney("769.65"),
+	NetUSDPendingBalance:     rize.MustParseMoney("343.16"),
+	NetUSDAvailableBalance:   rize.MustParseMoney("701.46"),
 	AssetBalances: []*rize.SyntheticAccountAssetBalance{{
 		AssetQuantity:        "769.65",
 		AssetType:            "USD",
-		CurrentUSDValue:      "769.65",
+		CurrentUSDValue:      rize.MustParseMoney("769.65"),
 		CustodialAccountUID:  "4uJMJjNd5wjzPaCj",
 		CustodialAccountName: "Second Checking",
 		Debit:                true,
@@ -35,8 +35,8 @@ var syntheticAccount = &rize.SyntheticAccount{
 	AccountNumber:               "123456789",
 	AccountNumberLastFour:       "1234",
 	RoutingNumber:               "0000000",
-	OpenedAt:                    time.Now(),
-	ClosedAt:                    time.Now(),
+	OpenedAt:                    rize.NewNullTime(time.Now()),
+	ClosedAt:                    rize.NewNullTime(time.Now()),
 	ClosedToSyntheticAccountUID: "4XkJnsfHsuqrxmeX",
 }
 
@@ -59,7 +59,7 @@ func TestSyntheticAccountService_List(t *testing.T) {
 		Offset:                   10,
 		SyntheticAccountTypeUID:  "q4mdMxMtjXfdbrjn",
 		SyntheticAccountCategory: "general",
-		Liability:                true,
+		Liability:                rize.Some(true),
 		Status:                   "active",
 		Sort:                     "name_asc",
 	}
@@ -106,8 +106,8 @@ func TestSyntheticAccountService_Get(t *testing.T) {
 
 func TestSyntheticAccountService_Update(t *testing.T) {
 	params := &rize.SyntheticAccountUpdateParams{
-		Name: "New Resource Name",
-		Note: "note",
+		Name: rize.Some("New Resource Name"),
+		Note: rize.Some("note"),
 	}
 	resp, err := rc.SyntheticAccounts.Update(context.Background(), "EhrQZJNjCd79LLYq", params)
 	if err != nil {
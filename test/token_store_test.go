@@ -0,0 +1,175 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk"
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// Create a mock auth server and return its URL along with the number of auth requests received
+func newTokenStoreTestServer(t *testing.T) (string, *int32) {
+	t.Helper()
+
+	var authRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+internal.BasePath+"/auth" {
+			atomic.AddInt32(&authRequests, 1)
+		}
+		resp, _ := json.Marshal(tokenResponse)
+		w.Write(resp)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL, &authRequests
+}
+
+func TestMemoryTokenStore_SharedBetweenClients(t *testing.T) {
+	url, authRequests := newTokenStoreTestServer(t)
+	store := rize.NewMemoryTokenStore()
+
+	for i := 0; i < 3; i++ {
+		_, err := rize.NewClient(&rize.Config{
+			ProgramUID: "program_uid",
+			HMACKey:    "hmac_key",
+			BaseURL:    url,
+			TokenStore: store,
+		})
+		if err != nil {
+			t.Fatal("Error creating client\n", err)
+		}
+	}
+
+	if *authRequests != 1 {
+		t.Fatalf("Expected clients to share a single token, got %d auth requests", *authRequests)
+	}
+}
+
+func TestTokenStore_OtherCredentials(t *testing.T) {
+	url, authRequests := newTokenStoreTestServer(t)
+
+	// Clients built from the same Config do not share the default store
+	cfg := &rize.Config{ProgramUID: "program_a", HMACKey: "hmac_key", BaseURL: url}
+	if _, err := rize.NewClient(cfg); err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+	if _, err := rize.NewClient(cfg, rize.WithCredentials("program_b", "hmac_key")); err != nil {
+		t.Fatal("Error creating client\n", err)
+	}
+	if cfg.TokenStore != nil {
+		t.Fatal("Expected the Config to be unchanged")
+	}
+	if n := atomic.LoadInt32(authRequests); n != 2 {
+		t.Fatalf("Expected a token for each program, got %d auth requests", n)
+	}
+
+	// A shared store ignores tokens issued for another program
+	store := rize.NewMemoryTokenStore()
+	for _, programUID := range []string{"program_a", "program_b", "program_a"} {
+		if _, err := rize.NewClient(&rize.Config{ProgramUID: programUID, HMACKey: "hmac_key", BaseURL: url, TokenStore: store}); err != nil {
+			t.Fatal("Error creating client\n", err)
+		}
+	}
+	if n := atomic.LoadInt32(authRequests); n != 5 {
+		t.Fatalf("Expected a token for each change of program, got %d auth requests", n)
+	}
+	if token, _ := store.Get(context.Background()); token.ProgramUID != "program_a" || token.BaseURL != url {
+		t.Fatalf("Expected the stored token to record its program, received %+v", token)
+	}
+}
+
+func TestFileTokenStore_SharedBetweenClients(t *testing.T) {
+	url, authRequests := newTokenStoreTestServer(t)
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	for i := 0; i < 3; i++ {
+		_, err := rize.NewClient(&rize.Config{
+			ProgramUID: "program_uid",
+			HMACKey:    "hmac_key",
+			BaseURL:    url,
+			TokenStore: rize.NewFileTokenStore(path),
+		})
+		if err != nil {
+			t.Fatal("Error creating client\n", err)
+		}
+	}
+
+	if *authRequests != 1 {
+		t.Fatalf("Expected clients to share a single token, got %d auth requests", *authRequests)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal("Expected token file to be created\n", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected token file to be private, got %s", info.Mode().Perm())
+	}
+
+	token, err := rize.NewFileTokenStore(path).Get(context.Background())
+	if err != nil || token.Token != tokenResponse.Token {
+		t.Fatalf("Expected stored token %q, got %+v (%v)", tokenResponse.Token, token, err)
+	}
+}
+
+func TestFileTokenStore_Lock(t *testing.T) {
+	store := rize.NewFileTokenStore(filepath.Join(t.TempDir(), "token.json"))
+
+	unlock, err := store.Lock(context.Background())
+	if err != nil {
+		t.Fatal("Error acquiring lock\n", err)
+	}
+
+	// A second lock must wait for the first to be released
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	if _, err := store.Lock(ctx); err != context.DeadlineExceeded {
+		t.Fatal("Expected lock to be held\n", err)
+	}
+
+	unlock()
+	unlockAgain, err := store.Lock(context.Background())
+	if err != nil {
+		t.Fatal("Expected lock to be released\n", err)
+	}
+	unlockAgain()
+}
+
+func TestFileTokenStore_BreakAbandonedLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	store := rize.NewFileTokenStore(path)
+
+	unlockAbandoned, err := store.Lock(context.Background())
+	if err != nil {
+		t.Fatal("Error acquiring lock\n", err)
+	}
+
+	// Locks older than the lock timeout are broken
+	old := time.Now().Add(-internal.TokenStoreLockTimeout - time.Minute)
+	if err := os.Chtimes(path+".lock", old, old); err != nil {
+		t.Fatal("Error backdating lock\n", err)
+	}
+	unlock, err := store.Lock(context.Background())
+	if err != nil {
+		t.Fatal("Expected abandoned lock to be broken\n", err)
+	}
+
+	// The previous owner can no longer release the new lock
+	unlockAbandoned()
+	if _, err := os.Stat(path + ".lock"); err != nil {
+		t.Fatal("Expected the new lock to be kept\n", err)
+	}
+
+	unlock()
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Fatal("Expected the lock to be released\n", err)
+	}
+}
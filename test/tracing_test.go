@@ -0,0 +1,185 @@
+package rize_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-stomp/stomp/v3"
+	"github.com/go-stomp/stomp/v3/frame"
+	"github.com/rizefinance/rize-go-sdk"
+	"github.com/rizefinance/rize-go-sdk/mq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Create a TracerProvider that keeps all ended spans in memory
+func newTestTracerProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)), exporter
+}
+
+// Returns the ended span with the given name
+func findSpan(t *testing.T, exporter *tracetest.InMemoryExporter, name string) tracetest.SpanStub {
+	t.Helper()
+	for _, span := range exporter.GetSpans() {
+		if span.Name == name {
+			return span
+		}
+	}
+	t.Fatalf("Expected a %q span, got %d spans", name, len(exporter.GetSpans()))
+	return tracetest.SpanStub{}
+}
+
+// Returns the span attributes as a map
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+func TestTracing_APISpan(t *testing.T) {
+	provider, exporter := newTestTracerProvider()
+
+	var (
+		requests    int
+		traceparent string
+	)
+	client := newTestClient(t, &rize.Config{
+		TracerProvider: provider,
+		Propagator:     propagation.TraceContext{},
+		RetryPolicy:    testRetryPolicy,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		traceparent = r.Header.Get("traceparent")
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		resp, _ := json.Marshal(customer)
+		w.Write(resp)
+	})
+
+	if _, err := client.Customers.Get(context.Background(), "EhrQZJNjCd79LLYq"); err != nil {
+		t.Fatal("Error fetching customer\n", err)
+	}
+
+	span := findSpan(t, exporter, "Customers.Get")
+	attrs := spanAttributes(span)
+
+	if span.SpanKind != trace.SpanKindClient {
+		t.Fatalf("Expected a client span, got %s", span.SpanKind)
+	}
+	if v := attrs["rize.service"].AsString(); v != "Customers" {
+		t.Fatalf("Expected service Customers, got %q", v)
+	}
+	if v := attrs["http.route"].AsString(); v != "customers/{uid}" {
+		t.Fatalf("Expected path template customers/{uid}, got %q", v)
+	}
+	if v := attrs["http.method"].AsString(); v != http.MethodGet {
+		t.Fatalf("Expected method GET, got %q", v)
+	}
+	if v := attrs["http.status_code"].AsInt64(); v != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", v)
+	}
+	if v := attrs["rize.retry_count"].AsInt64(); v != 1 {
+		t.Fatalf("Expected 1 retry, got %d", v)
+	}
+
+	// The trace context is sent to the API
+	if want := span.SpanContext.TraceID().String(); traceparent == "" || traceparent[3:35] != want {
+		t.Fatalf("Expected traceparent header for trace %s, got %q", want, traceparent)
+	}
+}
+
+func TestTracing_APISpanError(t *testing.T) {
+	provider, exporter := newTestTracerProvider()
+
+	client := newTestClient(t, &rize.Config{TracerProvider: provider, RetryPolicy: noRetryPolicy}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":1002,"title":"Not found"}],"status":404}`))
+	})
+
+	client.Transfers.Get(context.Background(), "EhrQZJNjCd79LLYq")
+
+	span := findSpan(t, exporter, "Transfers.Get")
+	attrs := spanAttributes(span)
+
+	if span.Status.Code != codes.Error {
+		t.Fatalf("Expected error status, got %s", span.Status.Code)
+	}
+	if v := attrs["http.status_code"].AsInt64(); v != http.StatusNotFound {
+		t.Fatalf("Expected status 404, got %d", v)
+	}
+	if v := attrs["rize.error_code"].AsInt64(); v != 1002 {
+		t.Fatalf("Expected error code 1002, got %d", v)
+	}
+}
+
+func TestTracing_MQMessageSpan(t *testing.T) {
+	provider, exporter := newTestTracerProvider()
+
+	mc, err := mq.NewClient(&mq.Config{
+		Username:       "username",
+		Password:       "password",
+		ClientID:       "client_id",
+		TracerProvider: provider,
+		Propagator:     propagation.TraceContext{},
+	})
+	if err != nil {
+		t.Fatal("Error creating MQ client\n", err)
+	}
+
+	// Messages produced within an existing trace
+	parent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	sub := &stomp.Subscription{C: make(chan *stomp.Message, 2)}
+	for _, id := range []string{"message-1", "message-2"} {
+		sub.C <- &stomp.Message{
+			Destination: "/topic/client_id.sandbox.customer",
+			Header:      frame.NewHeader(frame.MessageId, id, "traceparent", parent),
+		}
+	}
+	close(sub.C)
+
+	var handled int
+	err = mc.MessageQueue.Listen(context.Background(), sub, func(ctx context.Context, msg *stomp.Message) error {
+		handled++
+		if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+			t.Fatal("Expected the handler context to carry the message span")
+		}
+		if handled == 2 {
+			return errors.New("handler failed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Error listening to subscription\n", err)
+	}
+
+	spans := exporter.GetSpans()
+	if handled != 2 || len(spans) != 2 {
+		t.Fatalf("Expected 2 messages and spans, got %d messages and %d spans", handled, len(spans))
+	}
+	for _, span := range spans {
+		if span.SpanKind != trace.SpanKindConsumer {
+			t.Fatalf("Expected a consumer span, got %s", span.SpanKind)
+		}
+		if span.Parent.TraceID().String() != "0af7651916cd43dd8448eb211c80319c" {
+			t.Fatalf("Expected the span to continue the producer trace, got %s", span.Parent.TraceID())
+		}
+	}
+	if v := spanAttributes(spans[0])["messaging.message.id"].AsString(); v != "message-1" {
+		t.Fatalf("Expected message ID message-1, got %q", v)
+	}
+	if spans[1].Status.Code != codes.Error {
+		t.Fatalf("Expected the failed message span to have an error status, got %s", spans[1].Status.Code)
+	}
+}
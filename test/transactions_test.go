@@ -20,21 +20,21 @@ var transaction = &rize.Transaction{
 	Description:                    "External transfer from \"Bank ABC\" to \"Emergency Cupcake Funds\"",
 	DestinationSyntheticAccountUID: "15gTLPAEzHxtSrU6",
 	ID:                             27,
-	InitialActionAt:                time.Now(),
+	InitialActionAt:                rize.NewNullTime(time.Now()),
 	MCC:                            "5200",
 	MerchantLocation:               "SPRINGFIELD VA",
 	MerchantName:                   "BOB'S WIDGETS",
 	MerchantNumber:                 "010000034261221",
 	NetAsset:                       "positive",
-	SettledAt:                      time.Now(),
+	SettledAt:                      rize.NewNullTime(time.Now()),
 	SettledIndex:                   8,
 	SourceSyntheticAccountUID:      "rJuYjZuLei2TZji9",
-	Status:                         "settled",
+	Status:                         rize.TransactionStatusSettled,
 	TransactionEventUIDs:           []string{"MB2yqBrm3c4bUbou"},
 	TransferUID:                    "1qVSAEjsV55vDZxX",
-	Type:                           "external_transfer",
+	Type:                           rize.TransactionTypeExternalTransfer,
 	UID:                            "SMwKC1osz77DTEiu",
-	USDollarAmount:                 "5.21",
+	USDollarAmount:                 rize.MustParseMoney("5.21"),
 }
 
 // Complete TransactionEvent{} response data
@@ -46,13 +46,13 @@ var transactionEvent = &rize.TransactionEvent{
 	DestinationCustodialAccountUID: "Gw4gr1T81YrvLT6M",
 	CustodialLineItemUIDs:          []string{"y4r8oTATb23MdGDF", "m9bED9iicUUk8YAc"},
 	Status:                         "settled",
-	USDollarAmount:                 "5.21",
+	USDollarAmount:                 rize.MustParseMoney("5.21"),
 	Type:                           "odfi_ach_deposit",
 	DebitCardUID:                   "h9MzupcjtA3LPW2e",
 	NetAsset:                       "positive",
 	Description:                    "Transfer from Bank ABC 123 to Rize 999",
 	CreatedAt:                      time.Now(),
-	SettledAt:                      time.Now(),
+	SettledAt:                      rize.NewNullTime(time.Now()),
 }
 
 // Complete SyntheticLineItem{} response data
@@ -62,8 +62,8 @@ var syntheticLineItem = &rize.SyntheticLineItem{
 	TransactionUID:         "YBHNH3BgykqrjLgz",
 	SyntheticAccountUID:    "exMDShw6yM3NHLYV",
 	Status:                 "settled",
-	USDollarAmount:         "-12.34",
-	RunningUSDollarBalance: "4.21",
+	USDollarAmount:         rize.MustParseMoney("-12.34"),
+	RunningUSDollarBalance: rize.MustParseMoney("4.21"),
 	RunningAssetBalance:    "4.21",
 	AssetQuantity:          "-12.34",
 	AssetType:              "USD",
@@ -72,7 +72,7 @@ var syntheticLineItem = &rize.SyntheticLineItem{
 	CustodialAccountName:   "Checking Account",
 	Description:            "Deposit from Bank ABC 123",
 	CreatedAt:              time.Now(),
-	SettledAt:              time.Now(),
+	SettledAt:              rize.NewNullTime(time.Now()),
 }
 
 // Complete CustodialLineItem{} response data
@@ -84,8 +84,8 @@ var custodialLineItem = &rize.CustodialLineItem{
 	CustodialAccountUID:    "Gw4gr1T81YrvLT6M",
 	DebitCardUID:           "h9MzupcjtA3LPW2e",
 	Status:                 "settled",
-	USDollarAmount:         "5.21",
-	RunningUSDollarBalance: "34.21",
+	USDollarAmount:         rize.MustParseMoney("5.21"),
+	RunningUSDollarBalance: rize.MustParseMoney("34.21"),
 	RunningAssetBalance:    "34.21",
 	AssetQuantity:          "5.21",
 	AssetType:              "USD",
@@ -94,7 +94,7 @@ var custodialLineItem = &rize.CustodialLineItem{
 	Description:            "Deposit from Bank ABC 123",
 	CreatedAt:              time.Now(),
 	OccurredAt:             time.Now(),
-	SettledAt:              time.Now(),
+	SettledAt:              rize.NewNullTime(time.Now()),
 }
 
 func TestTransactionService_List(t *testing.T) {
@@ -105,10 +105,10 @@ func TestTransactionService_List(t *testing.T) {
 		SourceSyntheticAccountUID:      "4XkJnsfHsuqrxmeX",
 		DestinationSyntheticAccountUID: "exMDShw6yM3NHLYV",
 		SyntheticAccountUID:            "4XkJnsfHsuqrxmeX",
-		Type:                           "card_refund",
+		Type:                           rize.TransactionTypeCardRefund,
 		ShowDeniedAuths:                true,
 		ShowExpired:                    true,
-		Status:                         "failed",
+		Status:                         rize.TransactionStatusFailed,
 		SearchDescription:              "Transfer%2A",
 		IncludeZero:                    true,
 		Limit:                          100,
@@ -205,8 +205,8 @@ func TestTransactionService_ListCustodialLineItems(t *testing.T) {
 		CustomerUID:         "uKxmLxUEiSj5h4M3",
 		CustodialAccountUID: "wTSMX1GubP21ev2h",
 		Status:              "voided",
-		USDollarAmountMax:   2,
-		USDollarAmountMin:   2,
+		USDollarAmountMax:   rize.Some(rize.NewMoney(2, 0)),
+		USDollarAmountMin:   rize.Some(rize.NewMoney(2, 0)),
 		TransactionEventUID: "MB2yqBrm3c4bUbou",
 		TransactionUID:      "SMwKC1osz77DTEiu",
 		Limit:               100,
@@ -16,10 +16,10 @@ var transfer = &rize.Transfer{
 	SourceSyntheticAccountUID:      "4XkJnsfHsuqrxmeX",
 	DestinationSyntheticAccountUID: "exMDShw6yM3NHLYV",
 	InitiatingCustomerUID:          "iDtmSA52zRhgN4iy",
-	Status:                         "pending",
+	Status:                         rize.TransferStatusPending,
 	CreatedAt:                      time.Now(),
-	USDTransferAmount:              "34.12",
-	USDRequestedAmount:             "12.34",
+	USDTransferAmount:              rize.MustParseMoney("34.12"),
+	USDRequestedAmount:             rize.MustParseMoney("12.34"),
 }
 
 func TestTransferService_List(t *testing.T) {
@@ -47,7 +47,7 @@ func TestTransferService_Create(t *testing.T) {
 		SourceSyntheticAccountUID:      "4XkJnsfHsuqrxmeX",
 		DestinationSyntheticAccountUID: "exMDShw6yM3NHLYV",
 		InitiatingCustomerUID:          "iDtmSA52zRhgN4iy",
-		USDTransferAmount:              "12.34",
+		USDTransferAmount:              rize.MustParseMoney("12.34"),
 	}
 	resp, err := rc.Transfers.Create(context.Background(), params)
 	if err != nil {
@@ -0,0 +1,153 @@
+package rize_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk"
+)
+
+func TestDate_JSON(t *testing.T) {
+	details := rize.CustomerDetails{DOB: rize.NewDate(1990, time.May, 17)}
+	b, err := json.Marshal(details)
+	if err != nil {
+		t.Fatal("Error marshalling CustomerDetails\n", err)
+	}
+	if string(b) != `{"dob":"1990-05-17"}` {
+		t.Fatalf("Unexpected JSON %s", b)
+	}
+
+	var decoded rize.CustomerDetails
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal("Error unmarshalling CustomerDetails\n", err)
+	}
+	if decoded.DOB.String() != "1990-05-17" || !decoded.DOB.Time().Equal(time.Date(1990, time.May, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Unexpected DOB %s", decoded.DOB)
+	}
+}
+
+func TestDate_Zero(t *testing.T) {
+	var d rize.Date
+	if b, _ := json.Marshal(d); string(b) != "null" {
+		t.Fatalf("Expected null for the zero Date, received %s", b)
+	}
+
+	for _, v := range []string{`null`, `""`} {
+		d := rize.NewDate(2000, time.January, 1)
+		if err := json.Unmarshal([]byte(v), &d); err != nil || !d.IsZero() {
+			t.Fatalf("Expected %s to unset the Date, received %s (%v)", v, d, err)
+		}
+	}
+}
+
+func TestDate_OmittedFromDetails(t *testing.T) {
+	// Updating other details must not clear the DOB
+	params := &rize.CustomerUpdateParams{Details: &rize.CustomerDetails{FirstName: "Olive"}}
+	b, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal("Error marshalling CustomerUpdateParams\n", err)
+	}
+	if string(b) != `{"details":{"first_name":"Olive"}}` {
+		t.Fatalf("Unexpected JSON %s", b)
+	}
+}
+
+func TestDate_Invalid(t *testing.T) {
+	for _, v := range []string{"1990-13-01", "17/05/1990", ""} {
+		if _, err := rize.ParseDate(v); err == nil {
+			t.Errorf("Expected error parsing %q", v)
+		}
+	}
+
+	var d rize.Date
+	if err := json.Unmarshal([]byte(`"1990/05/17"`), &d); err == nil {
+		t.Fatal("Expected error unmarshalling an invalid date")
+	}
+}
+
+func TestNullTime_JSON(t *testing.T) {
+	var txn rize.Transaction
+	if err := json.Unmarshal([]byte(`{"initial_action_at":"2022-11-01T12:00:00Z","settled_at":null}`), &txn); err != nil {
+		t.Fatal("Error unmarshalling Transaction\n", err)
+	}
+	if !txn.InitialActionAt.IsSet() || !txn.InitialActionAt.Time.Equal(time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Unexpected InitialActionAt %s", txn.InitialActionAt)
+	}
+	if txn.SettledAt.IsSet() {
+		t.Fatalf("Expected null SettledAt to be unset, received %s", txn.SettledAt)
+	}
+
+	// Unset timestamps round-trip as null rather than 0001-01-01
+	b, _ := json.Marshal(&txn)
+	var fields map[string]interface{}
+	json.Unmarshal(b, &fields)
+	if fields["settled_at"] != nil || fields["initial_action_at"] != "2022-11-01T12:00:00Z" {
+		t.Fatalf("Unexpected JSON %s", b)
+	}
+}
+
+func TestNullTime_Zero(t *testing.T) {
+	var ts rize.NullTime
+	if ts.IsSet() || !ts.IsZero() || ts.String() != "" {
+		t.Fatal("Expected the zero NullTime to be unset")
+	}
+
+	for _, v := range []string{`null`, `""`} {
+		ts := rize.NewNullTime(time.Now())
+		if err := json.Unmarshal([]byte(v), &ts); err != nil || ts.IsSet() {
+			t.Fatalf("Expected %s to unset the NullTime, received %s (%v)", v, ts, err)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`"yesterday"`), &ts); err == nil {
+		t.Fatal("Expected error unmarshalling an invalid timestamp")
+	}
+}
+
+func TestProfileResponse_JSON(t *testing.T) {
+	tests := []struct {
+		resp *rize.ProfileResponse
+		json string
+	}{
+		{rize.NewProfileResponse(`say "yes"`), `"say \"yes\""`},
+		{&rize.ProfileResponse{Num0: "a", Num1: "b"}, `{"0":"a","1":"b"}`},
+	}
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.resp)
+		if err != nil || string(b) != tt.json {
+			t.Fatalf("Expected %s, received %s (%v)", tt.json, b, err)
+		}
+
+		decoded := &rize.ProfileResponse{}
+		if err := json.Unmarshal(b, decoded); err != nil || *decoded != *tt.resp {
+			t.Fatalf("Expected %+v, received %+v (%v)", tt.resp, decoded, err)
+		}
+	}
+}
+
+func TestProfileResponse_Validate(t *testing.T) {
+	valid := []*rize.ProfileResponse{
+		rize.NewProfileResponse("yes"),
+		{Num0: "a"},
+		{Num0: "a", Num1: "b", Num2: "c"},
+	}
+	for _, p := range valid {
+		if err := p.Validate(); err != nil {
+			t.Errorf("Expected %+v to be valid, received %v", p, err)
+		}
+	}
+
+	invalid := []*rize.ProfileResponse{
+		nil,
+		{},
+		{Response: "yes", Num0: "a"},
+		{Num1: "b"},
+		{Num0: "a", Num2: "c"},
+	}
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Expected %+v to be invalid", p)
+		}
+	}
+}
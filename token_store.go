@@ -0,0 +1,172 @@
+package rize
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+)
+
+// TokenStore provides storage for the Auth token. A store can be shared by multiple clients, or by
+// multiple processes when backed by shared storage such as a file or Redis, so the token only has to
+// be fetched once.
+type TokenStore interface {
+	// Get returns the stored token, or nil if no token has been stored
+	Get(ctx context.Context) (*TokenCache, error)
+	// Set replaces the stored token
+	Set(ctx context.Context, token *TokenCache) error
+	// Lock acquires an exclusive lock on the store, blocking until the lock is available or the
+	// context is done. Token refreshes happen while holding the lock. Call unlock to release it
+	Lock(ctx context.Context) (unlock func(), err error)
+}
+
+// MemoryTokenStore keeps the Auth token in memory. It can be shared by multiple clients in the same process
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	lock  chan struct{}
+	token *TokenCache
+}
+
+// NewMemoryTokenStore creates an empty in-memory token store
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{lock: make(chan struct{}, 1)}
+}
+
+// Get returns a copy of the stored token
+func (s *MemoryTokenStore) Get(ctx context.Context) (*TokenCache, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil, nil
+	}
+	token := *s.token
+	return &token, nil
+}
+
+// Set stores a copy of the token
+func (s *MemoryTokenStore) Set(ctx context.Context, token *TokenCache) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := *token
+	s.token = &t
+	return nil
+}
+
+// Lock acquires the store lock
+func (s *MemoryTokenStore) Lock(ctx context.Context) (func(), error) {
+	select {
+	case s.lock <- struct{}{}:
+		return func() { <-s.lock }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// FileTokenStore keeps the Auth token in a JSON file, so it can be shared by processes on the same
+// host or on a shared volume. A lock file next to the token file (Path + ".lock") guards refreshes.
+type FileTokenStore struct {
+	// Location of the token file
+	Path string
+}
+
+// NewFileTokenStore creates a token store backed by the file at the given path
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path}
+}
+
+// Get reads the token from the file. Returns nil if the file does not exist yet
+func (s *FileTokenStore) Get(ctx context.Context) (*TokenCache, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token := &TokenCache{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// Set atomically replaces the token file. The file is only readable by the current user
+func (s *FileTokenStore) Set(ctx context.Context, token *TokenCache) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial token
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// Lock acquires the lock file and writes a random owner ID into it. Lock files left behind by a
+// crashed process are removed once they are older than internal.TokenStoreLockTimeout. Unlock only
+// removes the lock file if it is still owned by the caller
+func (s *FileTokenStore) Lock(ctx context.Context) (func(), error) {
+	lockPath := s.Path + ".lock"
+	owner := newIdempotencyKey()
+
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_, err = f.WriteString(owner)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(lockPath)
+				return nil, err
+			}
+			return func() { removeLock(lockPath, owner) }, nil
+		}
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		// Break abandoned locks. The owner is checked again before removing the lock, so a lock that
+		// another process has broken and acquired in the meantime is left alone
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > internal.TokenStoreLockTimeout {
+			if stale, err := os.ReadFile(lockPath); err == nil {
+				removeLock(lockPath, string(stale))
+			}
+			continue
+		}
+
+		if err := sleep(ctx, internal.TokenStorePollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Removes the lock file if it belongs to owner
+func removeLock(lockPath string, owner string) {
+	if data, err := os.ReadFile(lockPath); err == nil && string(data) == owner {
+		os.Remove(lockPath)
+	}
+}
@@ -0,0 +1,53 @@
+package rize
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/rizefinance/rize-go-sdk/internal"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Starts a client span for the API operation
+func (rc *Client) startSpan(ctx context.Context, op *Operation) (context.Context, trace.Span) {
+	return rc.tracer.Start(ctx, op.Name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			internal.AttrService.String(op.Service()),
+			internal.AttrOperation.String(op.Name),
+			semconv.HTTPMethodKey.String(op.Method),
+			semconv.HTTPRouteKey.String(op.PathTemplate),
+		),
+	)
+}
+
+// Records the outcome of the API operation and ends the span. Error messages are redacted since
+// they may include the response body
+func endSpan(span trace.Span, op *Operation, res *http.Response, err error) {
+	defer span.End()
+
+	span.SetAttributes(internal.AttrRetryCount.Int(op.retries()))
+
+	if res != nil {
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(res.StatusCode))
+	}
+
+	if err == nil {
+		return
+	}
+
+	var rerr *Error
+	if errors.As(err, &rerr) {
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(rerr.Status))
+		if len(rerr.Errors) > 0 {
+			span.SetAttributes(internal.AttrErrorCode.Int(rerr.Errors[0].Code))
+		}
+	}
+
+	msg := internal.RedactString(err.Error())
+	span.RecordError(errors.New(msg))
+	span.SetStatus(codes.Error, msg)
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
@@ -17,15 +16,15 @@ type transferService service
 
 // Transfer data type
 type Transfer struct {
-	UID                            string    `json:"uid,omitempty"`
-	ExternalUID                    string    `json:"external_uid,omitempty"`
-	SourceSyntheticAccountUID      string    `json:"source_synthetic_account_uid,omitempty"`
-	DestinationSyntheticAccountUID string    `json:"destination_synthetic_account_uid,omitempty"`
-	InitiatingCustomerUID          string    `json:"initiating_customer_uid,omitempty"`
-	Status                         string    `json:"status,omitempty"`
-	CreatedAt                      time.Time `json:"created_at,omitempty"`
-	USDTransferAmount              string    `json:"usd_transfer_amount,omitempty"`
-	USDRequestedAmount             string    `json:"usd_requested_amount,omitempty"`
+	UID                            string         `json:"uid,omitempty"`
+	ExternalUID                    string         `json:"external_uid,omitempty"`
+	SourceSyntheticAccountUID      string         `json:"source_synthetic_account_uid,omitempty"`
+	DestinationSyntheticAccountUID string         `json:"destination_synthetic_account_uid,omitempty"`
+	InitiatingCustomerUID          string         `json:"initiating_customer_uid,omitempty"`
+	Status                         TransferStatus `json:"status,omitempty"`
+	CreatedAt                      time.Time      `json:"created_at"`
+	USDTransferAmount              Money          `json:"usd_transfer_amount"`
+	USDRequestedAmount             Money          `json:"usd_requested_amount"`
 }
 
 // TransferListParams builds the query parameters used in querying Transfers
@@ -44,7 +43,7 @@ type TransferCreateParams struct {
 	SourceSyntheticAccountUID      string `json:"source_synthetic_account_uid"`
 	DestinationSyntheticAccountUID string `json:"destination_synthetic_account_uid"`
 	InitiatingCustomerUID          string `json:"initiating_customer_uid"`
-	USDTransferAmount              string `json:"usd_transfer_amount"`
+	USDTransferAmount              Money  `json:"usd_transfer_amount"`
 }
 
 // TransferListResponse is an API response containing a list of Transfers
@@ -54,84 +53,88 @@ type TransferListResponse struct {
 }
 
 // List retrieves a list of Transfers filtered by the given parameters
-func (t *transferService) List(ctx context.Context, params *TransferListParams) (*TransferListResponse, error) {
+func (t *transferService) List(ctx context.Context, params *TransferListParams, opts ...CallOption) (*TransferListResponse, error) {
 	// Build TransferListParams into query string params
 	v, err := query.Values(params)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := t.client.doRequest(ctx, http.MethodGet, "transfers", v, nil)
-	if err != nil {
+	response := &TransferListResponse{}
+	if _, err := t.client.doRequest(ctx, newOperation("Transfers.List", http.MethodGet, "transfers"), params, v, nil, response, opts...); err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	response := &TransferListResponse{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+// Iterate returns an Iterator over all Transfers matching the given parameters, fetching additional pages as needed
+func (t *transferService) Iterate(ctx context.Context, params *TransferListParams, opts *IteratorOptions, callOpts ...CallOption) *Iterator[*Transfer] {
+	if params == nil {
+		params = &TransferListParams{}
 	}
 
-	return response, nil
+	return NewIterator(ctx, params.Offset, func(ctx context.Context, offset int) ([]*Transfer, *ListResponse, error) {
+		// Copy the params so the caller's Offset is left untouched
+		page := *params
+		page.Offset = offset
+
+		response, err := t.List(ctx, &page, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, &response.ListResponse, nil
+	}, opts)
+}
+
+// ListAll retrieves all Transfers matching the given parameters across all pages
+func (t *transferService) ListAll(ctx context.Context, params *TransferListParams, opts *IteratorOptions, callOpts ...CallOption) ([]*Transfer, error) {
+	return t.Iterate(ctx, params, opts, callOpts...).All()
 }
 
 // Create will initiate a Transfer between two Synthetic Accounts
-func (t *transferService) Create(ctx context.Context, tc *TransferCreateParams) (*Transfer, error) {
+// If no ExternalUID is provided, the key set with WithIdempotencyKey is used, or one is generated.
+// The params are not modified; the ExternalUID is returned on the created resource
+func (t *transferService) Create(ctx context.Context, tc *TransferCreateParams, opts ...CallOption) (*Transfer, error) {
 	if tc.SourceSyntheticAccountUID == "" ||
 		tc.DestinationSyntheticAccountUID == "" ||
 		tc.InitiatingCustomerUID == "" ||
-		tc.USDTransferAmount == "" {
+		tc.USDTransferAmount.IsZero() {
 		return nil, fmt.Errorf("SourceSyntheticAccountUID, DestinationSyntheticAccountUID, InitiatingCustomerUID and USDTransferAmount are required")
 	}
 
-	bytesMessage, err := json.Marshal(tc)
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := t.client.doRequest(ctx, http.MethodPost, "transfers", nil, bytes.NewBuffer(bytesMessage))
+	p := *tc
+	p.ExternalUID = createExternalUID(p.ExternalUID, opts)
+	bytesMessage, err := json.Marshal(&p)
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
+	create := func(ctx context.Context, opts ...CallOption) (*Transfer, error) {
+		response := &Transfer{}
+		_, err := t.client.doRequest(ctx, newOperation("Transfers.Create", http.MethodPost, "transfers"), &p, nil, bytes.NewBuffer(bytesMessage), response, opts...)
+		return response, err
 	}
-
-	response := &Transfer{}
-	if err = json.Unmarshal(body, response); err != nil {
-		return nil, err
+	list := func(ctx context.Context, opts ...CallOption) ([]*Transfer, error) {
+		resp, err := t.List(ctx, &TransferListParams{ExternalUID: p.ExternalUID}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
 	}
 
-	return response, nil
+	return createWithExternalUID(ctx, t.client, opts, create, list)
 }
 
 // Get returns a single Transfer
-func (t *transferService) Get(ctx context.Context, uid string) (*Transfer, error) {
+func (t *transferService) Get(ctx context.Context, uid string, opts ...CallOption) (*Transfer, error) {
 	if uid == "" {
 		return nil, fmt.Errorf("UID is required")
 	}
 
-	res, err := t.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("transfers/%s", uid), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	response := &Transfer{}
-	if err = json.Unmarshal(body, response); err != nil {
+	if _, err := t.client.doRequest(ctx, newOperation("Transfers.Get", http.MethodGet, "transfers/{uid}", uid), nil, nil, nil, response, opts...); err != nil {
 		return nil, err
 	}
 
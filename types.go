@@ -0,0 +1,189 @@
+package rize
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DateFormat is the layout of a Date in API requests and responses
+const DateFormat = "2006-01-02"
+
+// Date is a calendar date without a time of day, such as a date of birth. It is sent to the API as
+// `2006-01-02`. The zero Date is sent as null
+type Date time.Time
+
+// NewDate returns the Date for the given year, month and day
+func NewDate(year int, month time.Month, day int) Date {
+	return Date(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// ParseDate parses a `2006-01-02` formatted date
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(DateFormat, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("rize: invalid date %q, expected YYYY-MM-DD", s)
+	}
+	return Date(t), nil
+}
+
+// Time returns the Date as a time.Time at midnight UTC
+func (d Date) Time() time.Time {
+	t := time.Time(d)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// IsZero reports whether the Date is not set
+func (d Date) IsZero() bool {
+	return time.Time(d).IsZero()
+}
+
+// String returns the Date formatted as `2006-01-02`, or an empty string if it is not set
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return time.Time(d).Format(DateFormat)
+}
+
+// MarshalJSON formats the Date as `2006-01-02`, or null if it is not set
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON parses a `2006-01-02` formatted date. Null and empty strings leave the Date unset
+func (d *Date) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*d = Date{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("rize: invalid date %s, expected YYYY-MM-DD", b)
+	}
+	if s == "" {
+		*d = Date{}
+		return nil
+	}
+
+	date, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
+
+// ProfileResponse contains the Customer's response to a Profile Requirement.
+// Use `Response` to submit a string value, or Num0/1/2 for an ordered list
+type ProfileResponse struct {
+	Response string `json:"-"`
+	Num0     string `json:"0,omitempty"`
+	Num1     string `json:"1,omitempty"`
+	Num2     string `json:"2,omitempty"`
+}
+
+// Used for casting ProfileResponse to prevent infinite loop when marshalling/unmarshalling
+type profileResponse ProfileResponse
+
+// NewProfileResponse returns a ProfileResponse with a string value
+func NewProfileResponse(response string) *ProfileResponse {
+	return &ProfileResponse{Response: response}
+}
+
+// IsZero reports whether the ProfileResponse has no value
+func (p *ProfileResponse) IsZero() bool {
+	return p == nil || (p.Response == "" && p.Num0 == "" && p.Num1 == "" && p.Num2 == "")
+}
+
+// Validate checks that the ProfileResponse has either a string value or an ordered list, starting
+// with Num0 and without gaps
+func (p *ProfileResponse) Validate() error {
+	switch {
+	case p.IsZero():
+		return fmt.Errorf("ProfileResponse is required")
+	case p.Response != "" && (p.Num0 != "" || p.Num1 != "" || p.Num2 != ""):
+		return fmt.Errorf("ProfileResponse must have either a Response or Num0/1/2, not both")
+	case p.Response == "" && (p.Num0 == "" || (p.Num1 == "" && p.Num2 != "")):
+		return fmt.Errorf("ProfileResponse list must be filled in order, starting with Num0")
+	}
+	return nil
+}
+
+// MarshalJSON encodes a string response as a JSON string, and a list response as an object
+func (p ProfileResponse) MarshalJSON() ([]byte, error) {
+	if p.Response != "" {
+		return json.Marshal(p.Response)
+	}
+
+	return json.Marshal((*profileResponse)(&p))
+}
+
+// UnmarshalJSON decodes both string and list responses
+func (p *ProfileResponse) UnmarshalJSON(b []byte) error {
+	var resp string
+	// Check for string response type
+	if err := json.Unmarshal(b, &resp); err == nil {
+		*p = ProfileResponse{Response: resp}
+		return nil
+	}
+	return json.Unmarshal(b, (*profileResponse)(p))
+}
+
+// NullTime is a timestamp that may be null, such as the time a Customer was locked or a Transaction
+// was settled. The zero NullTime is not set and is sent as null
+type NullTime struct {
+	Time  time.Time
+	Valid bool
+}
+
+// NewNullTime returns a NullTime set to t
+func NewNullTime(t time.Time) NullTime {
+	return NullTime{Time: t, Valid: true}
+}
+
+// IsSet reports whether the timestamp is set
+func (t NullTime) IsSet() bool {
+	return t.Valid
+}
+
+// IsZero reports whether the timestamp is not set
+func (t NullTime) IsZero() bool {
+	return !t.Valid
+}
+
+// String returns the timestamp formatted as RFC 3339, or an empty string if it is not set
+func (t NullTime) String() string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339Nano)
+}
+
+// MarshalJSON formats the timestamp as RFC 3339, or null if it is not set
+func (t NullTime) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Time)
+}
+
+// UnmarshalJSON parses an RFC 3339 timestamp. Null and empty strings leave the NullTime unset
+func (t *NullTime) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) || bytes.Equal(b, []byte(`""`)) {
+		*t = NullTime{}
+		return nil
+	}
+
+	var v time.Time
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*t = NewNullTime(v)
+	return nil
+}